@@ -0,0 +1,75 @@
+package openapi
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// commentsToText converts the comments of a protobuf element into plain text.
+// Leading comments are preferred over trailing ones. Lines of the same
+// paragraph are joined together while paragraphs are kept separated by an
+// empty line.
+func commentsToText(comments protogen.CommentSet) string {
+	text := cleanComments(comments.Leading)
+	if text == "" {
+		text = cleanComments(comments.Trailing)
+	}
+
+	return text
+}
+
+func cleanComments(comments protogen.Comments) string {
+	var (
+		paragraphs []string
+		lines      []string
+	)
+
+	for _, line := range strings.Split(string(comments), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			if len(lines) > 0 {
+				paragraphs = append(paragraphs, strings.Join(lines, " "))
+				lines = nil
+			}
+
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	if len(lines) > 0 {
+		paragraphs = append(paragraphs, strings.Join(lines, " "))
+	}
+
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// splitSummaryAndDescription uses the first paragraph of a text as a summary
+// and the remaining ones as its description. A text with a single paragraph
+// is only a summary.
+func splitSummaryAndDescription(text string) (string, string) {
+	parts := strings.SplitN(text, "\n\n", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
+// chooseDescription selects which description should be used between the one
+// declared by an annotation and the one from the source comments. The
+// annotated one is used unless it is empty, or comments are preferred and
+// available.
+func chooseDescription(annotation, comment string, preferComments bool) string {
+	if preferComments && comment != "" {
+		return comment
+	}
+
+	if annotation != "" {
+		return annotation
+	}
+
+	return comment
+}
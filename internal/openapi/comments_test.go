@@ -0,0 +1,25 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitSummaryAndDescription(t *testing.T) {
+	t.Run("single paragraph", func(t *testing.T) {
+		summary, description := splitSummaryAndDescription("Gets an example.")
+
+		a := assert.New(t)
+		a.Equal("Gets an example.", summary)
+		a.Empty(description)
+	})
+
+	t.Run("many paragraphs", func(t *testing.T) {
+		summary, description := splitSummaryAndDescription("Gets an example.\n\nBy its id.\n\nOr its name.")
+
+		a := assert.New(t)
+		a.Equal("Gets an example.", summary)
+		a.Equal("By its id.\n\nOr its name.", description)
+	})
+}
@@ -1,7 +1,6 @@
 package openapi
 
 import (
-	"regexp"
 	"strings"
 
 	"github.com/iancoleman/strcase"
@@ -15,16 +14,31 @@ import (
 // parserOptions is an internal helper struct to pass common arguments to all
 // function calls related to parsing the protobuf file into an OpenAPI object.
 type parserOptions struct {
-	enums             map[string][]string
+	preferComments    bool
+	oneofMode         OneofMode
+	version           Version
+	fieldNaming       pocket.FieldNaming
+	responses         map[string]*pocketpb.Response
+	headers           map[string]*pocketpb.ResponseHeader
+	enums             map[string]*protogen.Enum
+	messages          *messageIndex
 	file              *protogen.File
 	plugin            *protogen.Plugin
 	serviceExtensions *pocket.ServiceExtensions
 	service           *descriptor.ServiceDescriptorProto
+	protogenService   *protogen.Service
+
+	// operationIdPrefix prefixes the ids of the service operations, keeping
+	// them unique inside documents with many services.
+	operationIdPrefix string
 }
 
 type fieldToSchemaOptions struct {
+	preferComments  bool
+	fieldNaming     pocket.FieldNaming
 	field           *descriptor.FieldDescriptorProto
-	enums           map[string][]string
+	enums           map[string]*protogen.Enum
+	messages        *messageIndex
 	message         *descriptor.DescriptorProto
 	msgSchema       *protogen.Message
 	fieldExtensions *pocket.FieldExtensions
@@ -35,24 +49,24 @@ func trimPackagePath(name string) string {
 	return parts[len(parts)-1]
 }
 
-func findProtogenMessageByName(name string, plugin *protogen.Plugin) *protogen.Message {
-	for _, file := range plugin.FilesByPath {
-		for _, m := range file.Messages {
-			if name == m.GoIdent.GoName {
-				return m
-			}
+func findProtogenMethodByName(name string, service *protogen.Service) *protogen.Method {
+	for _, m := range service.Methods {
+		if name == string(m.Desc.Name()) {
+			return m
 		}
 	}
 
 	return nil
 }
 
-func findMessageByName(name string, plugin *protogen.Plugin) *descriptor.DescriptorProto {
-	for _, file := range plugin.FilesByPath {
-		for _, m := range file.Proto.MessageType {
-			if name == m.GetName() {
-				return m
-			}
+func findProtogenFieldByName(name string, message *protogen.Message) *protogen.Field {
+	if message == nil {
+		return nil
+	}
+
+	for _, f := range message.Fields {
+		if name == string(f.Desc.Name()) {
+			return f
 		}
 	}
 
@@ -61,32 +75,33 @@ func findMessageByName(name string, plugin *protogen.Plugin) *descriptor.Descrip
 
 func fieldToSchema(options *fieldToSchemaOptions) (string, *Schema) {
 	var (
-		fieldName = options.field.GetName()
-		opts      = &SchemaOptions{}
+		fieldName       = options.field.GetName()
+		opts            = parseFieldType(options.field, options.messages)
+		comment         string
+		enumDescription string
 	)
 
-	parseFieldType(options.field, opts)
+	if field := findProtogenFieldByName(fieldName, options.msgSchema); field != nil {
+		comment = commentsToText(field.Comments)
+	}
 
-	if options.field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED {
-		// Creates the Item.Schema
-		o := &SchemaOptions{
-			Ref: opts.Ref,
-		}
-		if o.Ref == "" {
-			o.Type = opts.Type
-		}
+	enumDescription = applyEnumValues(options.field, opts, options.enums)
 
-		// Change the main type to array
-		opts.Type = SchemaType_Array
-		opts.Items = NewSchema(o)
-		opts.Ref = ""
+	if value := options.messages.MapValueField(options.field); value != nil {
+		// Maps are objects whose property values have the map value type.
+		valueOpts := parseFieldType(value, options.messages)
+		enumDescription = applyEnumValues(value, valueOpts, options.enums)
+		opts = &SchemaOptions{
+			Type:                 SchemaType_Object,
+			AdditionalProperties: NewSchema(valueOpts),
+		}
 	}
 
-	if options.field.GetType() == descriptor.FieldDescriptorProto_TYPE_ENUM {
-		for name, values := range options.enums {
-			if trimPackagePath(options.field.GetTypeName()) == name {
-				opts.Enum = values
-			}
+	if options.field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED && opts.AdditionalProperties == nil {
+		// The field type becomes the type of the array items.
+		opts = &SchemaOptions{
+			Type:  SchemaType_Array,
+			Items: NewSchema(opts),
 		}
 	}
 
@@ -103,85 +118,76 @@ func fieldToSchema(options *fieldToSchemaOptions) (string, *Schema) {
 		opts.Description = options.fieldExtensions.Openapi.GetDescription()
 
 		if opts.Format == "" {
-			format := options.fieldExtensions.Openapi.GetFormat()
-			if format != pocketpb.PropertyFormat_PROPERTY_FORMAT_UNSPECIFIED && format != pocketpb.PropertyFormat_PROPERTY_FORMAT_STRING {
-				opts.Format = strcase.ToKebab(pocket.PropertyFormatTrimPrefix(format))
-			}
+			opts.Format = propertyFormat(options.fieldExtensions.Openapi.GetFormat())
 		}
 
 	}
 
-	return fieldName, NewSchema(opts)
-}
+	opts.Deprecated = options.field.GetOptions().GetDeprecated()
+	opts.Sunset = options.fieldExtensions.Openapi.GetSunset()
+	opts.Description = chooseDescription(opts.Description, comment, options.preferComments)
+	if enumDescription != "" {
+		opts.Description = strings.TrimSpace(opts.Description + "\n\n" + enumDescription)
+	}
 
-func parseFieldType(field *descriptor.FieldDescriptorProto, opts *SchemaOptions) {
-	switch field.GetType() {
-	case descriptor.FieldDescriptorProto_TYPE_STRING:
-		opts.Type = SchemaType_String
-	case descriptor.FieldDescriptorProto_TYPE_ENUM:
-		opts.Type = SchemaType_String
-	case descriptor.FieldDescriptorProto_TYPE_BOOL:
-		opts.Type = SchemaType_Bool
-	case descriptor.FieldDescriptorProto_TYPE_DOUBLE, descriptor.FieldDescriptorProto_TYPE_FLOAT:
-		opts.Type = SchemaType_Number
-	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
-		if isProtobufWrapper(field.GetTypeName()) {
-			switch getWrapperType(field.GetTypeName()) {
-			case "String":
-				opts.Type = SchemaType_String
-			case "Float", "Double":
-				opts.Type = SchemaType_Number
-			case "Bool":
-				opts.Type = SchemaType_Bool
-			default:
-				opts.Type = SchemaType_Integer
-			}
-
-			return
+	schema := NewSchema(opts)
+	if constraints, ok := fieldConstraints(options.field); ok {
+		enumType := options.field.GetTypeName()
+		if value := options.messages.MapValueField(options.field); value != nil {
+			enumType = value.GetTypeName()
 		}
 
-		if isProtobufTimestamp(field.GetTypeName()) {
-			opts.Type = SchemaType_String
-			opts.Format = "date-time"
-			return
-		}
+		applyFieldConstraints(schema, constraints, enumType, options.enums)
+	}
 
-		if isProtobufValue(field.GetTypeName()) {
-			// TODO: handle google.protobuf.Value type
-			return
-		}
+	return options.fieldNaming.FieldName(options.field), schema
+}
 
-		opts.Ref = refComponentsSchemas + trimPackagePath(field.GetTypeName())
-	default:
-		opts.Type = SchemaType_Integer
+// propertyFormat gives the schema format of an annotated property format.
+func propertyFormat(format pocketpb.PropertyFormat) string {
+	if format == pocketpb.PropertyFormat_PROPERTY_FORMAT_UNSPECIFIED || format == pocketpb.PropertyFormat_PROPERTY_FORMAT_STRING {
+		return ""
 	}
+
+	return strcase.ToKebab(pocket.PropertyFormatTrimPrefix(format))
 }
 
-func isFieldRequired(fieldExtensions *pocket.FieldExtensions) bool {
-	if fieldExtensions != nil && fieldExtensions.Openapi != nil {
-		if fieldExtensions.Openapi != nil {
-			return fieldExtensions.Openapi.GetRequired()
-		}
+// applyEnumValues sets the possible values of an enum field into its schema
+// options. It gives the description of its values, if any.
+func applyEnumValues(field *descriptor.FieldDescriptorProto, opts *SchemaOptions, enums map[string]*protogen.Enum) string {
+	if field.GetType() != descriptor.FieldDescriptorProto_TYPE_ENUM {
+		return ""
 	}
 
-	return false
-}
+	enum, ok := enums[strings.TrimPrefix(field.GetTypeName(), ".")]
+	if !ok {
+		return ""
+	}
 
-func isProtobufWrapper(name string) bool {
-	re := regexp.MustCompile(`google\.protobuf\..+Value`)
-	return re.MatchString(name)
+	opts.Enum = loadEnumFromProtogenEnum(enum)
+	return enumValuesDescription(enum)
 }
 
-func getWrapperType(name string) string {
-	re := regexp.MustCompile(`google\.protobuf\.(.+)Value`)
-	s := re.FindStringSubmatch(name)
-	return s[1]
-}
+func parseFieldType(field *descriptor.FieldDescriptorProto, messages *messageIndex) *SchemaOptions {
+	if field.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+		return scalarTypeSchema(field.GetType())
+	}
+
+	if opts, ok := wellKnownTypeSchema(field.GetTypeName()); ok {
+		return opts
+	}
 
-func isProtobufTimestamp(name string) bool {
-	return strings.Contains(name, "google.protobuf.Timestamp")
+	return &SchemaOptions{
+		Ref: refComponentsSchemas + messages.SchemaName(field.GetTypeName()),
+	}
 }
 
-func isProtobufValue(name string) bool {
-	return strings.Contains(name, "google.protobuf.Value")
+func isFieldRequired(fieldExtensions *pocket.FieldExtensions) bool {
+	if fieldExtensions != nil && fieldExtensions.Openapi != nil {
+		if fieldExtensions.Openapi != nil {
+			return fieldExtensions.Openapi.GetRequired()
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,51 @@
+package openapi
+
+// Constraints are the validation keywords that restrict the values of a
+// schema.
+type Constraints struct {
+	Minimum          *float64    `yaml:"minimum,omitempty" json:"minimum,omitempty"`
+	Maximum          *float64    `yaml:"maximum,omitempty" json:"maximum,omitempty"`
+	ExclusiveMinimum interface{} `yaml:"exclusiveMinimum,omitempty" json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum interface{} `yaml:"exclusiveMaximum,omitempty" json:"exclusiveMaximum,omitempty"`
+	MinLength        uint64      `yaml:"minLength,omitempty" json:"minLength,omitempty"`
+	MaxLength        *uint64     `yaml:"maxLength,omitempty" json:"maxLength,omitempty"`
+	MinItems         uint64      `yaml:"minItems,omitempty" json:"minItems,omitempty"`
+	MaxItems         *uint64     `yaml:"maxItems,omitempty" json:"maxItems,omitempty"`
+	UniqueItems      bool        `yaml:"uniqueItems,omitempty" json:"uniqueItems,omitempty"`
+	MinProperties    uint64      `yaml:"minProperties,omitempty" json:"minProperties,omitempty"`
+	MaxProperties    *uint64     `yaml:"maxProperties,omitempty" json:"maxProperties,omitempty"`
+}
+
+// setMinimum sets the lower bound of the schema values, which may exclude the
+// bound itself.
+func (c *Constraints) setMinimum(value float64, exclusive bool) {
+	c.Minimum = &value
+	c.ExclusiveMinimum = nil
+	if exclusive {
+		c.ExclusiveMinimum = true
+	}
+}
+
+// setMaximum sets the upper bound of the schema values, which may exclude the
+// bound itself.
+func (c *Constraints) setMaximum(value float64, exclusive bool) {
+	c.Maximum = &value
+	c.ExclusiveMaximum = nil
+	if exclusive {
+		c.ExclusiveMaximum = true
+	}
+}
+
+// toVersion31 converts exclusive bounds into the JSON Schema 2020-12 ones,
+// which hold the bound values instead of flags.
+func (c *Constraints) toVersion31() {
+	if c.ExclusiveMinimum == true {
+		c.ExclusiveMinimum = *c.Minimum
+		c.Minimum = nil
+	}
+
+	if c.ExclusiveMaximum == true {
+		c.ExclusiveMaximum = *c.Maximum
+		c.Maximum = nil
+	}
+}
@@ -7,50 +7,83 @@ import (
 	"github.com/iancoleman/strcase"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
 )
 
 // parseEnums parses all enums declared inside all protobuf files, even the
-// ones declared inside "imported" protobuf files. It will map their values
-// using the type fully-qualified name as key.
-func parseEnums(plugin *protogen.Plugin) map[string][]string {
-	enums := make(map[string][]string)
+// ones declared inside "imported" protobuf files. It will map them using the
+// type fully-qualified name as key.
+func parseEnums(plugin *protogen.Plugin) map[string]*protogen.Enum {
+	enums := make(map[string]*protogen.Enum)
 
 	for _, file := range plugin.Files {
-		for name, values := range loadEnumsFromFile(file) {
-			enums[name] = values
+		for name, enum := range loadEnumsFromFile(file) {
+			enums[name] = enum
 		}
 	}
 
 	return enums
 }
 
-func loadEnumsFromFile(file *protogen.File) map[string][]string {
-	enums := make(map[string][]string)
+func loadEnumsFromFile(file *protogen.File) map[string]*protogen.Enum {
+	enums := make(map[string]*protogen.Enum)
 
 	for _, enum := range file.Enums {
 		desc := enum.Desc.(protoreflect.Descriptor)
-		values := loadEnumFromProtogenEnum(enum)
-		enums[string(desc.FullName())] = values
+		enums[string(desc.FullName())] = enum
+	}
+
+	for _, msg := range file.Messages {
+		loadEnumsFromMessage(msg, enums)
 	}
 
 	return enums
 }
 
+// loadEnumsFromMessage loads all enums declared inside a message and inside
+// its nested messages.
+func loadEnumsFromMessage(msg *protogen.Message, enums map[string]*protogen.Enum) {
+	for _, enum := range msg.Enums {
+		enums[string(enum.Desc.FullName())] = enum
+	}
+
+	for _, nested := range msg.Messages {
+		loadEnumsFromMessage(nested, enums)
+	}
+}
+
 func loadEnumFromProtogenEnum(enum *protogen.Enum) []string {
 	var values []string
 	for _, v := range enum.Values {
-		values = append(values, trimEnumPrefix(v.GoIdent.GoName))
+		values = append(values, trimEnumPrefix(enum, v))
 	}
 
 	return values
 }
 
-func trimEnumPrefix(value string) string {
-	prefix := getEnumPrefix(value)
-	return strings.TrimPrefix(value, fmt.Sprintf("%s_%s_", prefix, strcase.ToScreamingSnake(prefix)))
+// enumValuesDescription gives a description of all enum values that have
+// comments or are deprecated, as a markdown list. It gives an empty string if
+// there is no such value.
+func enumValuesDescription(enum *protogen.Enum) string {
+	var lines []string
+
+	for _, v := range enum.Values {
+		text := strings.ReplaceAll(commentsToText(v.Comments), "\n\n", " ")
+		if v.Desc.Options().(*descriptor.EnumValueOptions).GetDeprecated() {
+			text = strings.TrimSpace("Deprecated. " + text)
+		}
+
+		if text != "" {
+			lines = append(lines, fmt.Sprintf("- `%s`: %s", trimEnumPrefix(enum, v), text))
+		}
+	}
+
+	return strings.Join(lines, "\n")
 }
 
-func getEnumPrefix(value string) string {
-	parts := strings.Split(value, "_")
-	return parts[0]
+// trimEnumPrefix removes the enum name prefix from one of its values, which
+// works for nested enums as well.
+func trimEnumPrefix(enum *protogen.Enum, value *protogen.EnumValue) string {
+	prefix := strcase.ToScreamingSnake(string(enum.Desc.Name())) + "_"
+	return strings.TrimPrefix(string(value.Desc.Name()), prefix)
 }
@@ -0,0 +1,26 @@
+package openapi
+
+import (
+	"fmt"
+)
+
+type Format int
+
+const (
+	// Format_YAML writes documents as openapi.yaml files.
+	Format_YAML Format = iota
+
+	// Format_JSON writes documents as openapi.json files.
+	Format_JSON
+)
+
+func ParseFormat(name string) (Format, error) {
+	switch name {
+	case "", "yaml":
+		return Format_YAML, nil
+	case "json":
+		return Format_JSON, nil
+	}
+
+	return Format_YAML, fmt.Errorf("unsupported OpenAPI format '%s'", name)
+}
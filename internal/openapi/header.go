@@ -0,0 +1,106 @@
+package openapi
+
+import (
+	"fmt"
+
+	pocketpb "github.com/rsfreitas/protoc-gen-pocket-extensions/options/pocket"
+)
+
+const refComponentsHeaders = "#/components/headers/"
+
+type Header struct {
+	Description string  `yaml:"description,omitempty" json:"description,omitempty"`
+	Required    bool    `yaml:"required,omitempty" json:"required,omitempty"`
+	Schema      *Schema `yaml:"schema,omitempty" json:"schema,omitempty"`
+
+	// Ref points to a header inside the document components. All other fields
+	// are empty when it is set.
+	Ref string `yaml:"$ref,omitempty" json:"$ref,omitempty"`
+}
+
+// buildResponseHeaders builds all headers that a response sets, by their
+// names.
+func buildResponseHeaders(headers []*pocketpb.ResponseHeader, options *parserOptions) (map[string]*Header, error) {
+	responseHeaders := make(map[string]*Header)
+
+	for _, h := range headers {
+		var (
+			name   = h.GetName()
+			header *Header
+		)
+
+		if h.Ref != nil {
+			if _, ok := options.headers[h.GetRef()]; !ok {
+				return nil, fmt.Errorf("unknown shared header '%s'", h.GetRef())
+			}
+
+			if name == "" {
+				name = h.GetRef()
+			}
+
+			header = &Header{
+				Ref: refComponentsHeaders + h.GetRef(),
+			}
+		} else {
+			header = buildHeader(h)
+		}
+
+		if name == "" {
+			return nil, fmt.Errorf("response headers must have a name")
+		}
+
+		if _, ok := responseHeaders[name]; ok {
+			return nil, fmt.Errorf("header '%s' declared more than once", name)
+		}
+
+		responseHeaders[name] = header
+	}
+
+	return responseHeaders, nil
+}
+
+// buildComponentsHeaders builds all shared headers of the service.
+func buildComponentsHeaders(options *parserOptions) (map[string]*Header, error) {
+	headers := make(map[string]*Header)
+
+	for name, h := range options.headers {
+		if name == "" {
+			return nil, fmt.Errorf("shared headers must have a name")
+		}
+
+		if h.Ref != nil {
+			return nil, fmt.Errorf("shared header '%s' cannot be a ref", name)
+		}
+
+		headers[name] = buildHeader(h)
+	}
+
+	return headers, nil
+}
+
+func buildHeader(h *pocketpb.ResponseHeader) *Header {
+	return &Header{
+		Description: h.GetDescription(),
+		Required:    h.GetRequired(),
+		Schema: NewSchema(&SchemaOptions{
+			Type:    headerSchemaType(h.GetType()),
+			Format:  propertyFormat(h.GetFormat()),
+			Example: h.GetExample(),
+		}),
+	}
+}
+
+func headerSchemaType(headerType pocketpb.HeaderType) SchemaType {
+	switch headerType {
+	case pocketpb.HeaderType_HEADER_TYPE_INTEGER:
+		return SchemaType_Integer
+
+	case pocketpb.HeaderType_HEADER_TYPE_NUMBER:
+		return SchemaType_Number
+
+	case pocketpb.HeaderType_HEADER_TYPE_BOOLEAN:
+		return SchemaType_Bool
+	}
+
+	return SchemaType_String
+}
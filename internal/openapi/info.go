@@ -0,0 +1,265 @@
+package openapi
+
+import (
+	"fmt"
+	"reflect"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/pocket"
+	pocketpb "github.com/rsfreitas/protoc-gen-pocket-extensions/options/pocket"
+)
+
+// filesInfo is the document information declared by protobuf files.
+type filesInfo struct {
+	info         *Info
+	servers      []*Server
+	tags         []*Tag
+	externalDocs *ExternalDocs
+
+	// titleFile and versionFile are the files declaring the document title
+	// and version, while titleConflict and versionConflict tell when other
+	// files declare different ones.
+	titleFile       string
+	versionFile     string
+	titleConflict   error
+	versionConflict error
+}
+
+// parseFilesInfo gives the document information declared by many files.
+// Every Info field, like the external docs, comes from the first file that
+// declares it, while the servers and tags of all files are merged. Files
+// declaring different titles or versions are conflicts, checked by
+// checkConflicts.
+func parseFilesInfo(files []*protogen.File) (*filesInfo, error) {
+	info := &filesInfo{
+		info: &Info{},
+	}
+
+	for _, file := range files {
+		var (
+			fileExtensions = pocket.GetFileExtensions(file.Proto)
+			fileInfo       = parseInfo(fileExtensions)
+			fileName       = file.Proto.GetName()
+		)
+
+		if info.titleConflict == nil {
+			info.titleConflict = infoConflict("title", info.info.Title, fileInfo.Title, info.titleFile, fileName)
+		}
+		if info.versionConflict == nil {
+			info.versionConflict = infoConflict("version", info.info.Version, fileInfo.Version, info.versionFile, fileName)
+		}
+		if info.info.Title == "" {
+			info.titleFile = fileName
+		}
+		if info.info.Version == "" {
+			info.versionFile = fileName
+		}
+
+		mergeInfo(info.info, fileInfo)
+
+		if info.externalDocs == nil {
+			info.externalDocs = parseExternalDocs(fileExtensions.ExternalDocs)
+		}
+
+		info.servers = mergeServers(info.servers, parseServersFromFileExtensions(fileExtensions))
+
+		fileTags := parseTags(fileExtensions)
+		if err := checkTags(fileTags); err != nil {
+			return nil, fmt.Errorf("file '%s': %w", file.Proto.GetName(), err)
+		}
+
+		tags, err := mergeTags(info.tags, fileTags)
+		if err != nil {
+			return nil, fmt.Errorf("file '%s': %w", file.Proto.GetName(), err)
+		}
+		info.tags = tags
+	}
+
+	return info, nil
+}
+
+// infoConflict gives an error when a file declares an Info field different
+// from the one declared by another file.
+func infoConflict(field, value, fileValue, file, fileName string) error {
+	if value == "" || fileValue == "" || value == fileValue {
+		return nil
+	}
+
+	return fmt.Errorf("files '%s' and '%s' declare different %ss, '%s' and '%s'", file, fileName, field, value, fileValue)
+}
+
+// checkConflicts validates that titles and versions declared differently by
+// many files are replaced by the settings.
+func (i *filesInfo) checkConflicts(settings *Settings) error {
+	var info *SettingsInfo
+	if settings != nil && settings.Info != nil {
+		info = settings.Info
+	}
+
+	if i.titleConflict != nil && (info == nil || info.Title == "") {
+		return i.titleConflict
+	}
+
+	if i.versionConflict != nil && (info == nil || info.Version == "") {
+		return i.versionConflict
+	}
+
+	return nil
+}
+
+// parseInfo builds the document Info from the file annotations.
+func parseInfo(fileExtensions *pocket.FileExtensions) *Info {
+	info := &Info{
+		Title:          fileExtensions.OpenapiTitle,
+		Version:        fileExtensions.OpenapiVersion,
+		Description:    fileExtensions.Description,
+		TermsOfService: fileExtensions.TermsOfService,
+	}
+
+	if contact := fileExtensions.Contact; contact != nil {
+		info.Contact = &Contact{
+			Name:  contact.GetName(),
+			Url:   contact.GetUrl(),
+			Email: contact.GetEmail(),
+		}
+	}
+
+	if license := fileExtensions.License; license != nil {
+		info.License = &License{
+			Name:       license.GetName(),
+			Url:        license.GetUrl(),
+			Identifier: license.GetIdentifier(),
+		}
+	}
+
+	return info
+}
+
+// mergeInfo sets the empty fields of an Info with the ones of another file.
+func mergeInfo(info, file *Info) {
+	if info.Title == "" {
+		info.Title = file.Title
+	}
+
+	if info.Version == "" {
+		info.Version = file.Version
+	}
+
+	if info.Description == "" {
+		info.Description = file.Description
+	}
+
+	if info.TermsOfService == "" {
+		info.TermsOfService = file.TermsOfService
+	}
+
+	if info.Contact == nil {
+		info.Contact = file.Contact
+	}
+
+	if info.License == nil {
+		info.License = file.License
+	}
+}
+
+// mergeServers adds the servers of a file that other files don't declare.
+func mergeServers(servers, file []*Server) []*Server {
+	urls := make(map[string]bool)
+	for _, server := range servers {
+		urls[server.Url] = true
+	}
+
+	for _, server := range file {
+		if !urls[server.Url] {
+			servers = append(servers, server)
+		}
+	}
+
+	return servers
+}
+
+// checkLicense validates the license of a document version. Identifiers
+// are only kept by OpenAPI 3.1 documents, where they cannot be used together
+// with URLs.
+func checkLicense(license *License, version Version) error {
+	if license == nil {
+		return nil
+	}
+
+	if version != Version_3_1 {
+		license.Identifier = ""
+		return nil
+	}
+
+	if license.Url != "" && license.Identifier != "" {
+		return fmt.Errorf("license '%s' cannot have both an URL and an identifier", license.Name)
+	}
+
+	return nil
+}
+
+func parseExternalDocs(docs *pocketpb.OpenapiExternalDocs) *ExternalDocs {
+	if docs == nil {
+		return nil
+	}
+
+	return &ExternalDocs{
+		Description: docs.GetDescription(),
+		Url:         docs.GetUrl(),
+	}
+}
+
+// parseTags gives the tags declared by the file annotations, in their
+// declaration order.
+func parseTags(fileExtensions *pocket.FileExtensions) []*Tag {
+	var tags []*Tag
+
+	for _, tag := range fileExtensions.Tags {
+		tags = append(tags, &Tag{
+			Name:         tag.GetName(),
+			Description:  tag.GetDescription(),
+			ExternalDocs: parseExternalDocs(tag.GetExternalDocs()),
+		})
+	}
+
+	return tags
+}
+
+// checkTags validates that tags are declared only once.
+func checkTags(tags []*Tag) error {
+	names := make(map[string]bool)
+
+	for _, tag := range tags {
+		if names[tag.Name] {
+			return fmt.Errorf("tag '%s' declared more than once", tag.Name)
+		}
+
+		names[tag.Name] = true
+	}
+
+	return nil
+}
+
+// mergeTags adds the tags of a file into the ones of other files. Files can
+// declare the same tags, as long as they are equal.
+func mergeTags(tags, file []*Tag) ([]*Tag, error) {
+	existing := make(map[string]*Tag)
+	for _, tag := range tags {
+		existing[tag.Name] = tag
+	}
+
+	for _, tag := range file {
+		other, ok := existing[tag.Name]
+		if !ok {
+			tags = append(tags, tag)
+			continue
+		}
+
+		if !reflect.DeepEqual(other, tag) {
+			return nil, fmt.Errorf("tag '%s' is different from the one of another file", tag.Name)
+		}
+	}
+
+	return tags, nil
+}
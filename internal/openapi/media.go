@@ -1,11 +1,14 @@
 package openapi
 
 type Media struct {
-	Schema *Schema
+	Schema   *Schema             `yaml:"schema" json:"schema"`
+	Examples map[string]*Example `yaml:"examples,omitempty" json:"examples,omitempty"`
 }
 
-func (m *Media) String(prefixSpacing int) string {
-	return m.Schema.String(prefixSpacing)
+// Example is a named example of a media value.
+type Example struct {
+	Summary string      `yaml:"summary,omitempty" json:"summary,omitempty"`
+	Value   interface{} `yaml:"value" json:"value"`
 }
 
 func NewMedia(schema *Schema) *Media {
@@ -0,0 +1,264 @@
+package openapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// messageIndex holds every message available for the plugin, even the nested
+// ones and the ones declared inside "imported" protobuf files, allowing them
+// to be found by their fully-qualified name or by their schema name.
+type messageIndex struct {
+	byFullName   map[string]*indexedMessage
+	bySchemaName map[string]*indexedMessage
+}
+
+type indexedMessage struct {
+	packageName string
+	schemaName  string
+	proto       *descriptor.DescriptorProto
+	message     *protogen.Message
+}
+
+// SchemaNaming is the strategy used to name message schemas inside the
+// components schemas.
+type SchemaNaming int
+
+const (
+	// SchemaNaming_Auto uses short names, like SchemaNaming_Short, but uses
+	// package-qualified names for messages whose short names conflict.
+	SchemaNaming_Auto SchemaNaming = iota
+
+	// SchemaNaming_Short uses the message name without its package. Conflicting
+	// names are reported as errors.
+	SchemaNaming_Short
+
+	// SchemaNaming_Package uses the message name prefixed by its package.
+	SchemaNaming_Package
+)
+
+// ParseSchemaNaming converts a strategy name into its SchemaNaming. An empty
+// name gives the default strategy.
+func ParseSchemaNaming(name string) (SchemaNaming, error) {
+	switch name {
+	case "", "auto":
+		return SchemaNaming_Auto, nil
+	case "short":
+		return SchemaNaming_Short, nil
+	case "package":
+		return SchemaNaming_Package, nil
+	}
+
+	return SchemaNaming_Auto, fmt.Errorf("unsupported schema naming strategy '%s'", name)
+}
+
+// reservedSchemaNames holds the names of the schemas created by the plugin
+// itself.
+var reservedSchemaNames = map[string]bool{
+	schemaNameValidationError:      true,
+	schemaNameFieldValidationError: true,
+	schemaNameDefaultError:         true,
+}
+
+func newMessageIndex(plugin *protogen.Plugin) *messageIndex {
+	index := &messageIndex{
+		byFullName:   make(map[string]*indexedMessage),
+		bySchemaName: make(map[string]*indexedMessage),
+	}
+
+	for _, file := range plugin.Files {
+		index.add(file.Proto.GetPackage(), file.Proto.MessageType, file.Messages)
+	}
+
+	return index
+}
+
+func (i *messageIndex) add(packageName string, descriptors []*descriptor.DescriptorProto, messages []*protogen.Message) {
+	for n, m := range messages {
+		var (
+			fullName = string(m.Desc.FullName())
+			msg      = &indexedMessage{
+				packageName: packageName,
+				schemaName:  nestedSchemaName(packageName, fullName),
+				proto:       descriptors[n],
+				message:     m,
+			}
+		)
+
+		i.byFullName[fullName] = msg
+		i.bySchemaName[msg.schemaName] = msg
+		i.add(packageName, descriptors[n].NestedType, m.Messages)
+	}
+}
+
+// nameSchemas sets the schema names of all messages reachable from a set of
+// protobuf type names (and from their fields) using a naming strategy.
+// Conflicts are only searched between these messages, since they are the
+// ones that can end up inside the document.
+func (i *messageIndex) nameSchemas(typeNames []string, naming SchemaNaming) error {
+	var (
+		shortNames []string
+		groups     = make(map[string][]*indexedMessage)
+	)
+
+	for _, msg := range i.reachable(typeNames) {
+		name := nestedSchemaName(msg.packageName, string(msg.message.Desc.FullName()))
+		if _, ok := groups[name]; !ok {
+			shortNames = append(shortNames, name)
+		}
+
+		groups[name] = append(groups[name], msg)
+	}
+
+	sort.Strings(shortNames)
+	for _, name := range shortNames {
+		var (
+			messages = groups[name]
+			conflict = len(messages) > 1 || reservedSchemaNames[name]
+		)
+
+		if conflict && naming == SchemaNaming_Short {
+			var fullNames []string
+			for _, msg := range messages {
+				fullNames = append(fullNames, string(msg.message.Desc.FullName()))
+			}
+
+			if reservedSchemaNames[name] {
+				return fmt.Errorf("message '%s' uses the reserved schema name '%s'", fullNames[0], name)
+			}
+
+			return fmt.Errorf("messages '%s' have the conflicting schema name '%s'",
+				strings.Join(fullNames, "', '"), name)
+		}
+
+		for _, msg := range messages {
+			msg.schemaName = name
+			if naming == SchemaNaming_Package || (conflict && naming == SchemaNaming_Auto) {
+				msg.schemaName = qualifiedSchemaName(msg.packageName, name)
+			}
+
+			// Qualified messages can no longer be found by their short names.
+			if msg.schemaName != name && i.bySchemaName[name] == msg {
+				delete(i.bySchemaName, name)
+			}
+		}
+	}
+
+	// Reached messages always win over the other ones when sharing a name.
+	for _, msg := range i.reachable(typeNames) {
+		i.bySchemaName[msg.schemaName] = msg
+	}
+
+	return nil
+}
+
+// reachable gives all messages, sorted by their fully-qualified names, that
+// can be reached from a set of type names by following message fields.
+func (i *messageIndex) reachable(typeNames []string) []*indexedMessage {
+	var (
+		messages []*indexedMessage
+		visited  = make(map[string]bool)
+		pending  = append([]string{}, typeNames...)
+	)
+
+	for len(pending) > 0 {
+		msg := i.FindByTypeName(pending[0])
+		pending = pending[1:]
+		if msg == nil {
+			continue
+		}
+
+		fullName := string(msg.message.Desc.FullName())
+		if visited[fullName] {
+			continue
+		}
+
+		visited[fullName] = true
+		messages = append(messages, msg)
+
+		for _, f := range msg.proto.Field {
+			if f.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+				continue
+			}
+
+			// Well-known types used by fields never become schemas.
+			if _, ok := wellKnownTypeSchema(f.GetTypeName()); !ok {
+				pending = append(pending, f.GetTypeName())
+			}
+		}
+	}
+
+	sort.Slice(messages, func(a, b int) bool {
+		return messages[a].message.Desc.FullName() < messages[b].message.Desc.FullName()
+	})
+
+	return messages
+}
+
+// FindByTypeName searches for a message using a protobuf type name, i.e.,
+// its fully-qualified name, with or without the leading dot.
+func (i *messageIndex) FindByTypeName(typeName string) *indexedMessage {
+	return i.byFullName[strings.TrimPrefix(typeName, ".")]
+}
+
+// MapValueField gives the value field of a map field, or nil if the field is
+// not a map.
+func (i *messageIndex) MapValueField(field *descriptor.FieldDescriptorProto) *descriptor.FieldDescriptorProto {
+	if field.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE ||
+		field.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+		return nil
+	}
+
+	entry := i.FindByTypeName(field.GetTypeName())
+	if entry == nil || !entry.proto.GetOptions().GetMapEntry() {
+		return nil
+	}
+
+	for _, f := range entry.proto.Field {
+		if f.GetName() == "value" {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// FindBySchemaName searches for a message using the name that it has inside
+// the components schemas.
+func (i *messageIndex) FindBySchemaName(name string) *indexedMessage {
+	return i.bySchemaName[name]
+}
+
+// SchemaName gives the name that a protobuf type must have inside the
+// components schemas. Unknown types have their package path removed.
+func (i *messageIndex) SchemaName(typeName string) string {
+	if msg := i.FindByTypeName(typeName); msg != nil {
+		return msg.schemaName
+	}
+
+	return trimPackagePath(typeName)
+}
+
+// nestedSchemaName gives a schema name for a message, where nested messages
+// carry their parents names, like 'Outer_Inner'.
+func nestedSchemaName(packageName, fullName string) string {
+	name := fullName
+	if packageName != "" {
+		name = strings.TrimPrefix(fullName, packageName+".")
+	}
+
+	return strings.ReplaceAll(name, ".", "_")
+}
+
+// qualifiedSchemaName gives a schema name prefixed by its package name.
+func qualifiedSchemaName(packageName, name string) string {
+	if packageName == "" {
+		return name
+	}
+
+	return packageName + "." + name
+}
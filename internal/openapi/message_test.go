@@ -0,0 +1,127 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/prototext"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// usersFiles declare a message that uses two messages sharing their short
+// names, besides one using a reserved schema name.
+var usersFiles = []string{`
+name: "admin.proto"
+package: "admin.v1"
+options { go_package: "example.com/admin/v1" }
+message_type {
+  name: "User"
+  field { name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "id" }
+}
+message_type {
+  name: "DefaultError"
+  field { name: "message" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "message" }
+}
+syntax: "proto3"
+`, `
+name: "users.proto"
+package: "users.v1"
+options { go_package: "example.com/users/v1" }
+dependency: "admin.proto"
+message_type {
+  name: "User"
+  field { name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "id" }
+}
+message_type {
+  name: "Users"
+  field { name: "user" number: 1 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".users.v1.User" json_name: "user" }
+  field { name: "admin" number: 2 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".admin.v1.User" json_name: "admin" }
+  nested_type {
+    name: "Page"
+    field { name: "size" number: 1 label: LABEL_OPTIONAL type: TYPE_INT32 json_name: "size" }
+  }
+}
+message_type {
+  name: "Failure"
+  field { name: "error" number: 1 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".admin.v1.DefaultError" json_name: "error" }
+}
+syntax: "proto3"
+`}
+
+// newTestPlugin builds a plugin from files in their text format descriptors,
+// generating the last one.
+func newTestPlugin(t *testing.T, contents ...string) *protogen.Plugin {
+	request := &pluginpb.CodeGeneratorRequest{}
+
+	for _, content := range contents {
+		var file descriptor.FileDescriptorProto
+		if err := prototext.Unmarshal([]byte(content), &file); err != nil {
+			t.Fatal(err)
+		}
+
+		request.ProtoFile = append(request.ProtoFile, &file)
+	}
+	request.FileToGenerate = []string{request.ProtoFile[len(request.ProtoFile)-1].GetName()}
+
+	plugin, err := protogen.Options{}.New(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return plugin
+}
+
+func TestNameSchemas(t *testing.T) {
+	t.Run("short", func(t *testing.T) {
+		messages := newMessageIndex(newTestPlugin(t, usersFiles...))
+		err := messages.nameSchemas([]string{".users.v1.Users"}, SchemaNaming_Short)
+		assert.ErrorContains(t, err, "messages 'admin.v1.User', 'users.v1.User' have the conflicting schema name 'User'")
+	})
+
+	t.Run("short without conflicts", func(t *testing.T) {
+		messages := newMessageIndex(newTestPlugin(t, usersFiles...))
+		err := messages.nameSchemas([]string{".users.v1.User", ".users.v1.Users.Page"}, SchemaNaming_Short)
+
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal("User", messages.SchemaName(".users.v1.User"))
+		a.Equal("Users_Page", messages.SchemaName(".users.v1.Users.Page"))
+		a.Equal(messages.FindByTypeName(".users.v1.User"), messages.FindBySchemaName("User"))
+	})
+
+	t.Run("reserved names", func(t *testing.T) {
+		messages := newMessageIndex(newTestPlugin(t, usersFiles...))
+		err := messages.nameSchemas([]string{".users.v1.Failure"}, SchemaNaming_Short)
+		assert.ErrorContains(t, err, "message 'admin.v1.DefaultError' uses the reserved schema name 'DefaultError'")
+	})
+
+	t.Run("package", func(t *testing.T) {
+		messages := newMessageIndex(newTestPlugin(t, usersFiles...))
+		err := messages.nameSchemas([]string{".users.v1.Users"}, SchemaNaming_Package)
+
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal("users.v1.Users", messages.SchemaName(".users.v1.Users"))
+		a.Equal("users.v1.User", messages.SchemaName(".users.v1.User"))
+		a.Equal("admin.v1.User", messages.SchemaName(".admin.v1.User"))
+		a.Nil(messages.FindBySchemaName("Users"))
+		a.Nil(messages.FindBySchemaName("User"))
+	})
+
+	t.Run("auto", func(t *testing.T) {
+		messages := newMessageIndex(newTestPlugin(t, usersFiles...))
+		err := messages.nameSchemas([]string{".users.v1.Users", ".users.v1.Failure"}, SchemaNaming_Auto)
+
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal("Users", messages.SchemaName(".users.v1.Users"))
+		a.Equal("users.v1.User", messages.SchemaName(".users.v1.User"))
+		a.Equal("admin.v1.User", messages.SchemaName(".admin.v1.User"))
+		a.Equal("admin.v1.DefaultError", messages.SchemaName(".admin.v1.DefaultError"))
+		a.Equal(messages.FindByTypeName(".admin.v1.User"), messages.FindBySchemaName("admin.v1.User"))
+		a.Nil(messages.FindBySchemaName("User"))
+		a.Nil(messages.FindBySchemaName("DefaultError"))
+	})
+}
@@ -0,0 +1,118 @@
+package openapi
+
+import (
+	"fmt"
+
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+type OneofMode int
+
+const (
+	// OneofMode_OneOf represents every oneof as a 'oneOf' list of schemas,
+	// each one requiring a single alternative of the group, plus a schema
+	// matching objects without any of them, since a oneof may be unset.
+	// Messages with more than a single oneof wrap them inside an 'allOf'
+	// list. No 'discriminator' is set, since the proto3 JSON mapping has no
+	// property naming the alternative that is set.
+	OneofMode_OneOf OneofMode = iota
+
+	// OneofMode_Extension keeps all alternatives as object properties and
+	// groups them with the 'x-oneof' extension. Objects with no property other
+	// than the alternatives of a single oneof also have 'maxProperties' set
+	// to 1.
+	OneofMode_Extension
+)
+
+func ParseOneofMode(name string) (OneofMode, error) {
+	switch name {
+	case "", "oneof":
+		return OneofMode_OneOf, nil
+	case "extension":
+		return OneofMode_Extension, nil
+	}
+
+	return OneofMode_OneOf, fmt.Errorf("unsupported oneof mode '%s'", name)
+}
+
+// oneofGroup holds the property schemas of a single oneof, in the order that
+// its fields are declared.
+type oneofGroup struct {
+	name       string
+	names      []string
+	properties map[string]*Schema
+}
+
+// oneofName gives the name of the oneof that a field belongs to. Synthetic
+// oneofs, created for proto3 optional fields, are not considered.
+func oneofName(message *descriptor.DescriptorProto, field *descriptor.FieldDescriptorProto) (string, bool) {
+	if field.OneofIndex == nil || field.GetProto3Optional() {
+		return "", false
+	}
+
+	return message.OneofDecl[field.GetOneofIndex()].GetName(), true
+}
+
+// applyOneofGroups adds the alternatives of all oneofs of a message to its
+// schema options.
+func applyOneofGroups(opts *SchemaOptions, groups []*oneofGroup, mode OneofMode) {
+	if len(groups) == 0 {
+		return
+	}
+
+	if mode == OneofMode_Extension {
+		opts.XOneof = make(map[string][]string)
+		for _, g := range groups {
+			for _, name := range g.names {
+				opts.Properties[name] = g.properties[name]
+			}
+
+			opts.XOneof[g.name] = g.names
+		}
+
+		if len(groups) == 1 && len(opts.Properties) == len(groups[0].names) {
+			maxProperties := uint64(1)
+			opts.MaxProperties = &maxProperties
+		}
+
+		return
+	}
+
+	var oneOfs [][]*Schema
+	for _, g := range groups {
+		var (
+			alternatives []*Schema
+			unset        []*Schema
+		)
+
+		for _, name := range g.names {
+			alternative := NewSchema(&SchemaOptions{
+				Properties: map[string]*Schema{
+					name: g.properties[name],
+				},
+			})
+			alternative.Required = []string{name}
+			alternatives = append(alternatives, alternative)
+
+			present := NewSchema(&SchemaOptions{})
+			present.Required = []string{name}
+			unset = append(unset, present)
+		}
+
+		alternatives = append(alternatives, NewSchema(&SchemaOptions{
+			Not: NewSchema(&SchemaOptions{AnyOf: unset}),
+		}))
+		oneOfs = append(oneOfs, alternatives)
+	}
+
+	if len(oneOfs) == 1 {
+		opts.OneOf = oneOfs[0]
+		return
+	}
+
+	for _, alternatives := range oneOfs {
+		opts.AllOf = append(opts.AllOf, NewSchema(&SchemaOptions{
+			OneOf: alternatives,
+		}))
+	}
+}
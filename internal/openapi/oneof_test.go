@@ -0,0 +1,139 @@
+package openapi
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// accepts tells if an object payload, given by its property names, matches
+// the composition keywords of a schema.
+func accepts(s *Schema, payload map[string]bool) bool {
+	for _, name := range s.Required {
+		if !payload[name] {
+			return false
+		}
+	}
+
+	if len(s.OneOf) > 0 {
+		matches := 0
+		for _, inner := range s.OneOf {
+			if accepts(inner, payload) {
+				matches++
+			}
+		}
+
+		if matches != 1 {
+			return false
+		}
+	}
+
+	if len(s.AnyOf) > 0 {
+		matches := 0
+		for _, inner := range s.AnyOf {
+			if accepts(inner, payload) {
+				matches++
+			}
+		}
+
+		if matches == 0 {
+			return false
+		}
+	}
+
+	for _, inner := range s.AllOf {
+		if !accepts(inner, payload) {
+			return false
+		}
+	}
+
+	if s.Not != nil && accepts(s.Not, payload) {
+		return false
+	}
+
+	return true
+}
+
+func newOneofGroup(name string, names ...string) *oneofGroup {
+	group := &oneofGroup{
+		name:       name,
+		names:      names,
+		properties: make(map[string]*Schema),
+	}
+
+	for _, n := range names {
+		group.properties[n] = NewSchema(&SchemaOptions{Type: SchemaType_String})
+	}
+
+	return group
+}
+
+func TestApplyOneofGroups(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		groups   []*oneofGroup
+		payloads map[string]bool
+	}{
+		{
+			name:   "single oneof",
+			groups: []*oneofGroup{newOneofGroup("method", "card", "pix")},
+			payloads: map[string]bool{
+				"":          true,
+				"card":      true,
+				"pix":       true,
+				"card,pix":  false,
+				"card,name": true,
+			},
+		},
+		{
+			name: "many oneofs",
+			groups: []*oneofGroup{
+				newOneofGroup("method", "card", "pix"),
+				newOneofGroup("target", "email", "sms"),
+			},
+			payloads: map[string]bool{
+				"":               true,
+				"card":           true,
+				"sms":            true,
+				"card,email":     true,
+				"email,sms":      false,
+				"card,pix,email": false,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			opts := &SchemaOptions{
+				Type:       SchemaType_Object,
+				Properties: map[string]*Schema{"name": NewSchema(&SchemaOptions{Type: SchemaType_String})},
+			}
+			applyOneofGroups(opts, test.groups, OneofMode_OneOf)
+			schema := NewSchema(opts)
+
+			for names, expected := range test.payloads {
+				payload := make(map[string]bool)
+				if names != "" {
+					for _, name := range strings.Split(names, ",") {
+						payload[name] = true
+					}
+				}
+
+				assert.Equal(t, expected, accepts(schema, payload), "payload '%s'", names)
+			}
+		})
+	}
+
+	t.Run("unset alternative", func(t *testing.T) {
+		opts := &SchemaOptions{Type: SchemaType_Object}
+		applyOneofGroups(opts, []*oneofGroup{newOneofGroup("method", "card", "pix")}, OneofMode_OneOf)
+
+		a := assert.New(t)
+		a.Len(opts.OneOf, 3)
+
+		unset := opts.OneOf[2]
+		a.Empty(unset.Required)
+		a.Len(unset.Not.AnyOf, 2)
+		a.Equal([]string{"card"}, unset.Not.AnyOf[0].Required)
+		a.Equal([]string{"pix"}, unset.Not.AnyOf[1].Required)
+	})
+}
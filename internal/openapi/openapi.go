@@ -1,100 +1,461 @@
 package openapi
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
 	"google.golang.org/protobuf/compiler/protogen"
 	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	"gopkg.in/yaml.v2"
 
 	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/pocket"
 	pocketpb "github.com/rsfreitas/protoc-gen-pocket-extensions/options/pocket"
 )
 
 type Openapi struct {
-	Info              *Info
-	Servers           []*Server
-	PathItems         map[string]map[string]*Operation `yaml:"paths"`
-	Components        *Components
-	ServiceExtensions *pocket.ServiceExtensions
+	Version           string                           `yaml:"openapi" json:"openapi"`
+	JsonSchemaDialect string                           `yaml:"jsonSchemaDialect,omitempty" json:"jsonSchemaDialect,omitempty"`
+	Info              *Info                            `yaml:"info" json:"info"`
+	Servers           []*Server                        `yaml:"servers,omitempty" json:"servers,omitempty"`
+	PathItems         map[string]map[string]*Operation `yaml:"paths" json:"paths"`
+	Webhooks          map[string]map[string]*Operation `yaml:"webhooks,omitempty" json:"webhooks,omitempty"`
+	Components        *Components                      `yaml:"components" json:"components"`
+	Security          SecurityRequirements             `yaml:"security,omitempty" json:"security,omitempty"`
+	Tags              []*Tag                           `yaml:"tags,omitempty" json:"tags,omitempty"`
+	ExternalDocs      *ExternalDocs                    `yaml:"externalDocs,omitempty" json:"externalDocs,omitempty"`
 }
 
 type Info struct {
-	Title   string
-	Version string
-	NoAuth  bool
+	Title          string   `yaml:"title" json:"title"`
+	Version        string   `yaml:"version" json:"version"`
+	Description    string   `yaml:"description,omitempty" json:"description,omitempty"`
+	TermsOfService string   `yaml:"termsOfService,omitempty" json:"termsOfService,omitempty"`
+	Contact        *Contact `yaml:"contact,omitempty" json:"contact,omitempty"`
+	License        *License `yaml:"license,omitempty" json:"license,omitempty"`
+}
+
+type Contact struct {
+	Name  string `yaml:"name,omitempty" json:"name,omitempty"`
+	Url   string `yaml:"url,omitempty" json:"url,omitempty"`
+	Email string `yaml:"email,omitempty" json:"email,omitempty"`
+}
+
+type License struct {
+	Name       string `yaml:"name" json:"name"`
+	Identifier string `yaml:"identifier,omitempty" json:"identifier,omitempty"`
+	Url        string `yaml:"url,omitempty" json:"url,omitempty"`
+}
+
+type ExternalDocs struct {
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	Url         string `yaml:"url" json:"url"`
+}
+
+// Tag describes a group of operations.
+type Tag struct {
+	Name         string        `yaml:"name" json:"name"`
+	Description  string        `yaml:"description,omitempty" json:"description,omitempty"`
+	ExternalDocs *ExternalDocs `yaml:"externalDocs,omitempty" json:"externalDocs,omitempty"`
 }
 
 type Server struct {
-	Url         string
-	Description string
+	Url         string `yaml:"url" json:"url"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
 }
 
 type Components struct {
-	Schemas   map[string]*Schema
-	Responses map[string]*Response
+	Schemas         map[string]*Schema         `yaml:"schemas" json:"schemas"`
+	Responses       map[string]*Response       `yaml:"responses,omitempty" json:"responses,omitempty"`
+	Headers         map[string]*Header         `yaml:"headers,omitempty" json:"headers,omitempty"`
+	SecuritySchemes map[string]*SecurityScheme `yaml:"securitySchemes,omitempty" json:"securitySchemes,omitempty"`
 }
 
-func (o *Openapi) HasAuth() bool {
-	return o.ServiceExtensions.Service != nil && o.ServiceExtensions.Service.GetSecurityScheme() != nil
+// YAML gives the document in the YAML format.
+func (o *Openapi) YAML() (string, error) {
+	out, err := yaml.Marshal(o)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
 }
 
-func (o *Openapi) SecurityScheme(tabSize int) string {
-	return buildSecuritySchemeFromServiceExtensions(o.ServiceExtensions, tabSize)
+// JSON gives the document in the JSON format. Characters like '<' and '&',
+// common in descriptions and patterns, are kept instead of escaped.
+func (o *Openapi) JSON() (string, error) {
+	var (
+		out     bytes.Buffer
+		encoder = json.NewEncoder(&out)
+	)
+
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(o); err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
 }
 
-func FromProto(file *protogen.File, plugin *protogen.Plugin) (*Openapi, error) {
+// Options gathers all options that change how an OpenAPI document is built.
+type Options struct {
+	// Settings optionally overrides some of the document information.
+	Settings *Settings
+
+	// PreferComments makes descriptions from protobuf source comments take
+	// precedence over annotated ones. Otherwise, comments are only used when
+	// no annotated description is available.
+	PreferComments bool
+
+	// SchemaNaming sets how message schemas are named.
+	SchemaNaming SchemaNaming
+
+	// OneofMode sets how oneof fields are represented inside schemas.
+	OneofMode OneofMode
+
+	// FieldNaming sets how fields are named inside schemas and parameters.
+	FieldNaming pocket.FieldNaming
+
+	// Version sets the OpenAPI version of the document.
+	Version Version
+
+	// ServiceOperationIds prefixes operation ids by the names of their
+	// services, like 'AdminService_GetExample', keeping apart methods of
+	// different services with the same names.
+	ServiceOperationIds bool
+}
+
+// FromProto builds an OpenAPI document from a protobuf file, with the
+// operations of all its services.
+func FromProto(file *protogen.File, plugin *protogen.Plugin, options *Options) (*Openapi, error) {
+	return FromProtoFiles([]*protogen.File{file}, plugin, options)
+}
+
+// FromProtoFiles builds a single OpenAPI document from many protobuf files,
+// usually the ones of the same app, with the operations of all their
+// services.
+func FromProtoFiles(files []*protogen.File, plugin *protogen.Plugin, options *Options) (*Openapi, error) {
+	var services []*fileService
+	for _, file := range files {
+		for _, service := range file.Services {
+			services = append(services, &fileService{file: file, service: service})
+		}
+	}
+
+	return fromServices(files, services, plugin, options)
+}
+
+// FromProtoService builds an OpenAPI document with the operations of a single
+// service of a protobuf file.
+func FromProtoService(file *protogen.File, service *protogen.Service, plugin *protogen.Plugin, options *Options) (*Openapi, error) {
+	return fromServices([]*protogen.File{file}, []*fileService{{file: file, service: service}}, plugin, options)
+}
+
+// fileService is a service together with the protobuf file declaring it.
+type fileService struct {
+	file    *protogen.File
+	service *protogen.Service
+}
+
+func fromServices(files []*protogen.File, services []*fileService, plugin *protogen.Plugin, options *Options) (*Openapi, error) {
 	var (
 		enums          = parseEnums(plugin)
-		extensions     = pocket.GetServiceExtensions(file.Proto.Service[0])
-		fileExtensions = pocket.GetFileExtensions(file.Proto)
+		messages       = newMessageIndex(plugin)
+		serviceOptions []*parserOptions
+		rootTypeNames  []string
 	)
 
+	for _, s := range services {
+		var (
+			serviceProto   = serviceDescriptor(s.file, s.service)
+			fileExtensions = pocket.GetFileExtensions(s.file.Proto)
+		)
+
+		// Services without annotations are not HTTP services.
+		extensions := pocket.GetServiceExtensions(serviceProto)
+		if extensions == nil {
+			continue
+		}
+
+		// Initialize parser options that can be used throughout the parsing
+		// calls.
+		parserOptions := &parserOptions{
+			preferComments:    options.PreferComments,
+			oneofMode:         options.OneofMode,
+			version:           options.Version,
+			fieldNaming:       options.FieldNaming,
+			file:              s.file,
+			plugin:            plugin,
+			enums:             enums,
+			messages:          messages,
+			serviceExtensions: extensions,
+			service:           serviceProto,
+			protogenService:   s.service,
+			responses:         extensions.SharedResponses(fileExtensions),
+			headers:           extensions.SharedHeaders(fileExtensions),
+		}
+
+		serviceOptions = append(serviceOptions, parserOptions)
+		rootTypeNames = append(rootTypeNames, serviceTypeNames(serviceProto)...)
+		rootTypeNames = append(rootTypeNames, responsesTypeNames(parserOptions)...)
+	}
+
 	// FIXME: should we return nil here?
-	if extensions == nil {
+	if len(serviceOptions) == 0 {
 		return nil, nil
 	}
 
-	// Initialize parser options that can be used throughout the parsing calls.
-	parserOptions := &parserOptions{
-		file:              file,
-		plugin:            plugin,
-		enums:             enums,
-		serviceExtensions: extensions,
-		service:           file.Proto.Service[0],
+	if options.ServiceOperationIds {
+		for _, o := range serviceOptions {
+			o.operationIdPrefix = o.service.GetName() + "_"
+		}
 	}
 
-	operations, err := parseOperations(parserOptions)
-	if err != nil {
+	if err := messages.nameSchemas(rootTypeNames, options.SchemaNaming); err != nil {
+		return nil, err
+	}
+
+	if err := checkMessagesSunset(messages.reachable(rootTypeNames)); err != nil {
 		return nil, err
 	}
 
-	components, err := parseComponents(parserOptions, operations)
+	var (
+		operations = make(map[string]map[string]*Operation)
+		webhooks   = make(map[string]map[string]*Operation)
+		components = &Components{
+			Schemas:         make(map[string]*Schema),
+			Responses:       make(map[string]*Response),
+			Headers:         make(map[string]*Header),
+			SecuritySchemes: make(map[string]*SecurityScheme),
+		}
+		security    = buildDocumentSecurity(serviceOptions[0].serviceExtensions)
+		schemaFiles = make(map[string]string)
+	)
+
+	// Services with different default security schemes set them on their
+	// operations instead of on the document.
+	for _, o := range serviceOptions[1:] {
+		if !reflect.DeepEqual(security, buildDocumentSecurity(o.serviceExtensions)) {
+			security = nil
+			break
+		}
+	}
+
+	for _, o := range serviceOptions {
+		serviceName := o.service.GetName()
+
+		serviceOperations, err := parseOperations(o)
+		if err != nil {
+			return nil, err
+		}
+
+		serviceWebhooks, err := parseWebhooks(o)
+		if err != nil {
+			return nil, err
+		}
+
+		serviceComponents, err := parseComponents(o, serviceOperations, serviceWebhooks)
+		if err != nil {
+			return nil, err
+		}
+
+		if security == nil {
+			inheritServiceSecurity(serviceOperations, buildDocumentSecurity(o.serviceExtensions))
+			inheritServiceSecurity(serviceWebhooks, buildDocumentSecurity(o.serviceExtensions))
+		}
+
+		if err := mergePathItems(operations, serviceOperations, serviceName); err != nil {
+			return nil, err
+		}
+
+		if err := mergeWebhooks(webhooks, serviceWebhooks, serviceName); err != nil {
+			return nil, err
+		}
+
+		if err := mergeComponents(components, serviceComponents, serviceName, o.file.Proto.GetName(), schemaFiles); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkOperationIds(operations, webhooks); err != nil {
+		return nil, err
+	}
+
+	declared, err := parseFilesInfo(files)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Openapi{
-		ServiceExtensions: extensions,
-		PathItems:         operations,
-		Components:        components,
-		Servers:           parseServersFromFileExtensions(fileExtensions),
-		Info: &Info{
-			Title:   fileExtensions.OpenapiTitle,
-			Version: fileExtensions.OpenapiVersion,
-		},
-	}, nil
+	if err := declared.checkConflicts(options.Settings); err != nil {
+		return nil, err
+	}
+
+	options.Settings.applyInfo(declared.info)
+	if err := checkLicense(declared.info.License, options.Version); err != nil {
+		return nil, err
+	}
+
+	tags := options.Settings.applyTags(declared.tags)
+	if err := checkTags(tags); err != nil {
+		return nil, err
+	}
+
+	document := &Openapi{
+		Version:      options.Version.String(),
+		PathItems:    operations,
+		Webhooks:     webhooks,
+		Components:   components,
+		Security:     security,
+		Servers:      options.Settings.applyServers(declared.servers),
+		Info:         declared.info,
+		Tags:         tags,
+		ExternalDocs: options.Settings.applyExternalDocs(declared.externalDocs),
+	}
+
+	if options.Version == Version_3_1 {
+		document.JsonSchemaDialect = jsonSchemaDialect
+		for _, schema := range document.schemas() {
+			schema.toVersion31()
+		}
+	}
+
+	return document, nil
 }
 
-func parseComponents(options *parserOptions, pathItems map[string]map[string]*Operation) (*Components, error) {
-	var (
-		errorCodes = getResponseErrorCodesFromPaths(pathItems)
-		schemas    = buildComponentsSchemas(getSchemaNamesFromPaths(pathItems), options)
-	)
+// serviceDescriptor gives the descriptor of a service of a file.
+func serviceDescriptor(file *protogen.File, service *protogen.Service) *descriptor.ServiceDescriptorProto {
+	for i, s := range file.Services {
+		if s == service {
+			return file.Proto.Service[i]
+		}
+	}
 
-	for name, schema := range responseErrorComponentsSchemas(errorCodes) {
+	return nil
+}
+
+// inheritServiceSecurity sets the default security requirements of a service
+// on its operations that don't declare their own ones.
+func inheritServiceSecurity(pathItems map[string]map[string]*Operation, requirements SecurityRequirements) {
+	for _, path := range pathItems {
+		for _, operation := range path {
+			if operation.SecuritySchemes == nil && len(requirements) > 0 {
+				r := requirements
+				operation.SecuritySchemes = &r
+			}
+		}
+	}
+}
+
+// schemas gives all schemas declared by the document, without their inner
+// schemas.
+func (o *Openapi) schemas() []*Schema {
+	var schemas []*Schema
+
+	for _, items := range []map[string]map[string]*Operation{o.PathItems, o.Webhooks} {
+		for _, path := range items {
+			for _, operation := range path {
+				for _, p := range operation.Parameters {
+					schemas = append(schemas, p.Schema)
+				}
+
+				if operation.HasRequestBody() {
+					for _, media := range operation.RequestBody.Content {
+						schemas = append(schemas, media.Schema)
+					}
+				}
+
+				for _, response := range operation.Responses {
+					schemas = append(schemas, response.schemas()...)
+				}
+			}
+		}
+	}
+
+	for _, schema := range o.Components.Schemas {
+		schemas = append(schemas, schema)
+	}
+
+	for _, response := range o.Components.Responses {
+		schemas = append(schemas, response.schemas()...)
+	}
+
+	for _, header := range o.Components.Headers {
+		schemas = append(schemas, header.Schema)
+	}
+
+	return schemas
+}
+
+// serviceTypeNames gives the type names of all messages that the service
+// methods use as input or output.
+func serviceTypeNames(service *descriptor.ServiceDescriptorProto) []string {
+	var names []string
+
+	for _, method := range service.GetMethod() {
+		names = append(names, method.GetInputType(), method.GetOutputType())
+	}
+
+	return names
+}
+
+// checkMessagesSunset validates the sunset dates of messages and of their
+// fields.
+func checkMessagesSunset(messages []*indexedMessage) error {
+	for _, msg := range messages {
+		var (
+			name       = msg.message.Desc.FullName()
+			extensions = pocket.GetMessageExtensions(msg.proto)
+		)
+
+		if err := pocket.CheckSunset(extensions.OpenapiMessage.GetSunset(), msg.proto.GetOptions().GetDeprecated()); err != nil {
+			return fmt.Errorf("message '%s': %w", name, err)
+		}
+
+		for _, f := range msg.proto.Field {
+			fieldExtensions := pocket.GetFieldExtensions(f)
+			if err := pocket.CheckSunset(fieldExtensions.Openapi.GetSunset(), f.GetOptions().GetDeprecated()); err != nil {
+				return fmt.Errorf("field '%s.%s': %w", name, f.GetName(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseComponents(options *parserOptions, pathItems, webhooks map[string]map[string]*Operation) (*Components, error) {
+	responses, err := buildComponentsResponses(options)
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := buildComponentsHeaders(options)
+	if err != nil {
+		return nil, err
+	}
+
+	securitySchemes, err := buildComponentsSecuritySchemes(options.serviceExtensions)
+	if err != nil {
+		return nil, err
+	}
+
+	schemaNames := append(getSchemaNamesFromPaths(pathItems), getSchemaNamesFromPaths(webhooks)...)
+	for _, response := range responses {
+		schemaNames = append(schemaNames, response.Schemas()...)
+	}
+
+	schemas := buildComponentsSchemas(schemaNames, options)
+	for name, schema := range responseErrorComponentsSchemas(schemaNames) {
 		schemas[name] = schema
 	}
 
 	return &Components{
-		Schemas: schemas,
+		Schemas:         schemas,
+		Responses:       responses,
+		Headers:         headers,
+		SecuritySchemes: securitySchemes,
 	}, nil
 }
 
@@ -120,63 +481,30 @@ func getSchemaNamesFromPaths(pathItems map[string]map[string]*Operation) []strin
 	for k := range names {
 		schemas = append(schemas, k)
 	}
+	sort.Strings(schemas)
 
 	return schemas
 }
 
-func getResponseErrorCodesFromPaths(pathItems map[string]map[string]*Operation) map[pocketpb.ResponseCode]bool {
-	stringCodeToResponseCode := func(code string) pocketpb.ResponseCode {
-		switch code {
-		case "400":
-			return pocketpb.ResponseCode_RESPONSE_CODE_BAD_REQUEST
-		case "401":
-			return pocketpb.ResponseCode_RESPONSE_CODE_UNAUTHORIZED
-		case "404":
-			return pocketpb.ResponseCode_RESPONSE_CODE_NOT_FOUND
-		case "412":
-			return pocketpb.ResponseCode_RESPONSE_CODE_PRECONDITION_FAILED
-		case "500":
-			return pocketpb.ResponseCode_RESPONSE_CODE_INTERNAL_ERROR
-		}
-
-		return pocketpb.ResponseCode_RESPONSE_CODE_OK
-	}
-
-	codes := make(map[pocketpb.ResponseCode]bool)
-	for _, path := range pathItems {
-		for _, operation := range path {
-			for _, code := range operation.ResponseErrorCodes() {
-				codes[stringCodeToResponseCode(code)] = true
-			}
-		}
-	}
-
-	return codes
-}
-
 func buildComponentsSchemas(schemaNames []string, options *parserOptions) map[string]*Schema {
 	schemas := make(map[string]*Schema)
 
-	for _, s := range schemaNames {
-		name := trimPackagePath(s)
-		if msg := findMessageByName(name, options.plugin); msg != nil {
-			schema := messageToSchema(msg, options.enums, findProtogenMessageByName(name, options.plugin))
-			schemas[name] = schema
+	for _, name := range schemaNames {
+		if msg := options.messages.FindBySchemaName(name); msg != nil {
+			// Well-known types used directly by methods keep their JSON
+			// representation.
+			if opts, ok := wellKnownTypeSchema(string(msg.message.Desc.FullName())); ok {
+				schemas[name] = NewSchema(opts)
+				continue
+			}
 
-			for _, p := range schema.Properties {
-				refName := ""
-				if p.Ref != "" {
-					refName = p.RefName()
-				}
-				if p.Items != nil {
-					refName = p.Items.RefName()
-				}
+			schema := messageToSchema(msg.proto, msg.message, options)
+			schemas[name] = schema
 
-				if refName != "" {
-					if _, ok := schemas[refName]; !ok {
-						for n, s := range buildComponentsSchemas([]string{refName}, options) {
-							schemas[n] = s
-						}
+			for _, refName := range schema.References() {
+				if _, ok := schemas[refName]; !ok {
+					for n, s := range buildComponentsSchemas([]string{refName}, options) {
+						schemas[n] = s
 					}
 				}
 			}
@@ -186,8 +514,12 @@ func buildComponentsSchemas(schemaNames []string, options *parserOptions) map[st
 	return schemas
 }
 
-func messageToSchema(message *descriptor.DescriptorProto, enums map[string][]string, msgSchema *protogen.Message) *Schema {
-	properties := make(map[string]*Schema)
+func messageToSchema(message *descriptor.DescriptorProto, msgSchema *protogen.Message, options *parserOptions) *Schema {
+	var (
+		properties = make(map[string]*Schema)
+		groups     []*oneofGroup
+		groupIndex = make(map[string]*oneofGroup)
+	)
 
 	for _, f := range message.Field {
 		fieldExtensions := pocket.GetFieldExtensions(f)
@@ -196,33 +528,72 @@ func messageToSchema(message *descriptor.DescriptorProto, enums map[string][]str
 		}
 
 		schemaOptions := &fieldToSchemaOptions{
+			preferComments:  options.preferComments,
+			fieldNaming:     options.fieldNaming,
 			field:           f,
-			enums:           enums,
+			enums:           options.enums,
+			messages:        options.messages,
 			message:         message,
 			msgSchema:       msgSchema,
 			fieldExtensions: fieldExtensions,
 		}
 
-		if name, schema := fieldToSchema(schemaOptions); schema != nil {
+		name, schema := fieldToSchema(schemaOptions)
+		if schema == nil {
+			continue
+		}
+
+		oneof, ok := oneofName(message, f)
+		if !ok {
 			properties[name] = schema
+			continue
+		}
+
+		group, ok := groupIndex[oneof]
+		if !ok {
+			group = &oneofGroup{
+				name:       oneof,
+				properties: make(map[string]*Schema),
+			}
+			groupIndex[oneof] = group
+			groups = append(groups, group)
 		}
+
+		group.names = append(group.names, name)
+		group.properties[name] = schema
+	}
+
+	description := ""
+	if msgSchema != nil {
+		description = commentsToText(msgSchema.Comments)
 	}
 
-	return NewSchema(&SchemaOptions{
-		Type:       SchemaType_Object,
-		Properties: properties,
-	})
+	opts := &SchemaOptions{
+		Type:        SchemaType_Object,
+		Description: description,
+		Properties:  properties,
+		Deprecated:  message.GetOptions().GetDeprecated(),
+		Sunset:      pocket.GetMessageExtensions(message).OpenapiMessage.GetSunset(),
+	}
+	applyOneofGroups(opts, groups, options.oneofMode)
+
+	return NewSchema(opts)
 }
 
-// responseErrorComponentsSchemas gives all error schemas that an API must have.
-func responseErrorComponentsSchemas(errorCodes map[pocketpb.ResponseCode]bool) map[string]*Schema {
+// responseErrorComponentsSchemas gives all error schemas that an API must
+// have, i.e., the ones referenced by the schema names.
+func responseErrorComponentsSchemas(schemaNames []string) map[string]*Schema {
 	var (
-		dup     = make(map[pocketpb.ResponseCode]bool)
+		names   = make(map[string]bool)
 		schemas = make(map[string]*Schema)
 	)
 
-	if _, ok := errorCodes[pocketpb.ResponseCode_RESPONSE_CODE_BAD_REQUEST]; ok {
-		schemas["FieldValidationError"] = NewSchema(&SchemaOptions{
+	for _, name := range schemaNames {
+		names[name] = true
+	}
+
+	if names[schemaNameValidationError] {
+		schemas[schemaNameFieldValidationError] = NewSchema(&SchemaOptions{
 			Type: SchemaType_Object,
 			Properties: map[string]*Schema{
 				"field": NewSchema(&SchemaOptions{
@@ -237,13 +608,13 @@ func responseErrorComponentsSchemas(errorCodes map[pocketpb.ResponseCode]bool) m
 			},
 		})
 
-		schemas["ValidationError"] = NewSchema(&SchemaOptions{
+		schemas[schemaNameValidationError] = NewSchema(&SchemaOptions{
 			Type: SchemaType_Object,
 			Properties: map[string]*Schema{
 				"errors": NewSchema(&SchemaOptions{
 					Type: SchemaType_Array,
 					Items: NewSchema(&SchemaOptions{
-						Ref: refComponentsSchemas + "FieldValidationError",
+						Ref: refComponentsSchemas + schemaNameFieldValidationError,
 					}),
 				}),
 				"message": NewSchema(&SchemaOptions{
@@ -253,18 +624,8 @@ func responseErrorComponentsSchemas(errorCodes map[pocketpb.ResponseCode]bool) m
 		})
 	}
 
-	// Duplicates the errorCodes map so we can remove items without affecting
-	// the original one
-	for k := range errorCodes {
-		dup[k] = true
-	}
-
-	// Remove the BAD_REQUEST error since it's the only one that uses a
-	// different schema.
-	delete(dup, pocketpb.ResponseCode_RESPONSE_CODE_BAD_REQUEST)
-
-	if len(dup) > 0 {
-		schemas["DefaultError"] = NewSchema(&SchemaOptions{
+	if names[schemaNameDefaultError] {
+		schemas[schemaNameDefaultError] = NewSchema(&SchemaOptions{
 			Type: SchemaType_Object,
 			Properties: map[string]*Schema{
 				"errors": NewSchema(&SchemaOptions{
@@ -0,0 +1,31 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSON(t *testing.T) {
+	a := assert.New(t)
+	document := &Openapi{
+		Version: "3.0.3",
+		Info: &Info{
+			Title:   "<examples> & owners",
+			Version: "0.1.0",
+		},
+	}
+
+	out, err := document.JSON()
+	a.NoError(err)
+	a.Equal(`{
+  "openapi": "3.0.3",
+  "info": {
+    "title": "<examples> & owners",
+    "version": "0.1.0"
+  },
+  "paths": null,
+  "components": null
+}
+`, out)
+}
@@ -3,6 +3,7 @@ package openapi
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	descriptor "google.golang.org/protobuf/types/descriptorpb"
 
@@ -14,16 +15,25 @@ const (
 	refComponentsResponses = "#/components/responses/"
 )
 
+// Names of the error schemas created by the plugin.
+const (
+	schemaNameValidationError      = "ValidationError"
+	schemaNameFieldValidationError = "FieldValidationError"
+	schemaNameDefaultError         = "DefaultError"
+)
+
 type Operation struct {
-	Name            string
-	Summary         string `yaml:"summary"`
-	Description     string `yaml:"description"`
-	Id              string `yaml:"operationId"`
-	Tags            []string
-	Parameters      []*Parameter
-	Responses       map[string]*Response
-	RequestBody     *RequestBody          `yaml:"requestBody"`
-	SecuritySchemes []map[string][]string `yaml:"security"`
+	Name            string                `yaml:"-" json:"-"`
+	Tags            []string              `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Summary         string                `yaml:"summary,omitempty" json:"summary,omitempty"`
+	Description     string                `yaml:"description,omitempty" json:"description,omitempty"`
+	Id              string                `yaml:"operationId" json:"operationId"`
+	Deprecated      bool                  `yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
+	XSunset         string                `yaml:"x-sunset,omitempty" json:"x-sunset,omitempty"`
+	SecuritySchemes *SecurityRequirements `yaml:"security,omitempty" json:"security,omitempty"`
+	Parameters      []*Parameter          `yaml:"parameters,omitempty" json:"parameters,omitempty"`
+	RequestBody     *RequestBody          `yaml:"requestBody,omitempty" json:"requestBody,omitempty"`
+	Responses       Responses             `yaml:"responses" json:"responses"`
 
 	methodExtensions *pocket.MethodExtensions
 }
@@ -40,102 +50,164 @@ func (o *Operation) Schemas() []string {
 	// Adds the schema that the body is using
 	if o.HasRequestBody() {
 		for _, media := range o.RequestBody.Content {
-			schemas = append(schemas, media.Schema.RefName())
+			schemas = append(schemas, media.Schema.References()...)
 		}
 	}
 
 	// Adds the schemas that the responses are using
 	for _, response := range o.Responses {
-		for _, media := range response.Content {
-			schemas = append(schemas, media.Schema.RefName())
-		}
+		schemas = append(schemas, response.Schemas()...)
 	}
 
 	return schemas
 }
 
-func (o *Operation) ResponseErrorCodes() []string {
+func parseOperations(options *parserOptions) (map[string]map[string]*Operation, error) {
 	var (
-		strStatusOk = fmt.Sprintf("%v", http.StatusOK)
-		codes       []string
+		pathItems = make(map[string]map[string]*Operation)
+		owners    = make(map[string]string) // methods by their endpoints
 	)
 
-	for code := range o.Responses {
-		if code != strStatusOk {
-			codes = append(codes, code)
+	for _, method := range options.service.GetMethod() {
+		extensions := pocket.GetMethodExtensions(method)
+		if extensions == nil {
+			return nil, fmt.Errorf("cannot handle method '%s' without HTTP API definitions", method.GetName())
+		}
+
+		// Webhooks are not API endpoints.
+		if extensions.OpenapiMethod.GetWebhook() != "" {
+			continue
+		}
+
+		// Every additional binding becomes an operation of its own.
+		for index, binding := range extensions.HttpBindings() {
+			operation, err := newOperation(method, options, binding)
+			if err != nil {
+				return nil, err
+			}
+
+			if index > 0 {
+				operation.Id = fmt.Sprintf("%s_%d", operation.Id, index)
+			}
+
+			template, err := binding.PathTemplate()
+			if err != nil {
+				return nil, fmt.Errorf("method '%s': %w", method.GetName(), err)
+			}
+
+			var (
+				httpMethod, _ = binding.HttpMethodAndEndpoint()
+				endpoint      = template.OpenapiPath()
+			)
+
+			route := strings.ToUpper(httpMethod) + " " + endpoint
+			if owner, exists := owners[route]; exists {
+				if owner == method.GetName() {
+					return nil, fmt.Errorf("method '%s' declares the endpoint '%s' more than once",
+						method.GetName(), route)
+				}
+
+				return nil, fmt.Errorf("methods '%s' and '%s' declare the same endpoint '%s'",
+					owner, method.GetName(), route)
+			}
+			owners[route] = method.GetName()
+
+			if path, ok := pathItems[endpoint]; ok {
+				path[httpMethod] = operation
+			} else {
+				pathItems[endpoint] = map[string]*Operation{
+					httpMethod: operation,
+				}
+			}
 		}
 	}
 
-	return codes
+	return pathItems, nil
 }
 
-func parseOperations(options *parserOptions) (map[string]map[string]*Operation, error) {
-	pathItems := make(map[string]map[string]*Operation)
+// parseWebhooks builds the operations of all webhook methods, by their
+// webhook names.
+func parseWebhooks(options *parserOptions) (map[string]map[string]*Operation, error) {
+	webhooks := make(map[string]map[string]*Operation)
 
 	for _, method := range options.service.GetMethod() {
 		extensions := pocket.GetMethodExtensions(method)
-		if extensions == nil {
-			return nil, fmt.Errorf("cannot handle method '%s' without HTTP API definitions", method.GetName())
+		name := extensions.OpenapiMethod.GetWebhook()
+		if name == "" {
+			continue
+		}
+
+		if options.version != Version_3_1 {
+			return nil, fmt.Errorf("method '%s' is a webhook, which requires OpenAPI 3.1", method.GetName())
 		}
 
-		operation, err := newOperation(method, options, extensions)
+		if _, ok := webhooks[name]; ok {
+			return nil, fmt.Errorf("webhook '%s' declared more than once", name)
+		}
+
+		operation, err := newOperation(method, options, extensions.WebhookBinding())
 		if err != nil {
 			return nil, err
 		}
 
-		httpMethod, endpoint := extensions.HttpMethodAndEndpoint()
-		path, ok := pathItems[endpoint]
-		if ok {
-			path[httpMethod] = operation
-		}
-		if !ok {
-			pathItems[endpoint] = map[string]*Operation{
-				httpMethod: operation,
-			}
+		webhooks[name] = map[string]*Operation{
+			strings.ToLower(http.MethodPost): operation,
 		}
 	}
 
-	return pathItems, nil
+	return webhooks, nil
 }
 
 func newOperation(method *descriptor.MethodDescriptorProto, options *parserOptions, extensions *pocket.MethodExtensions) (*Operation, error) {
-	var (
-		requestBody     *RequestBody
-		securitySchemes []map[string][]string
-		httpMethod      = extensions.HttpMethod()
-	)
-
-	if httpMethod == http.MethodPost || httpMethod == http.MethodPut {
-		req, err := newRequestBody(method, options.plugin, extensions)
+	var requestBody *RequestBody
+	if extensions.HasBody() {
+		req, err := newRequestBody(method, options, extensions)
 		if err != nil {
 			return nil, err
 		}
 		requestBody = req
 	}
 
-	parameters, err := parseOperationParameters(method, options, extensions)
+	// Webhooks have no endpoint and send all fields inside their bodies.
+	var parameters []*Parameter
+	if extensions.GoogleApi != nil {
+		p, err := parseOperationParameters(method, options, extensions)
+		if err != nil {
+			return nil, err
+		}
+		parameters = p
+	}
+
+	securitySchemes, err := buildSecurityRequirements(extensions, options.serviceExtensions)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("method '%s': %w", method.GetName(), err)
 	}
 
-	if extensions.HasKrillHttpExtension() {
-		securitySchemes = append(securitySchemes,
-			map[string][]string{
-				"authorization": extensions.Method.GetScope(),
-			})
+	deprecated := method.GetOptions().GetDeprecated()
+	if err := pocket.CheckSunset(extensions.OpenapiMethod.GetSunset(), deprecated); err != nil {
+		return nil, fmt.Errorf("method '%s': %w", method.GetName(), err)
 	}
 
-	responses, err := buildPathItemResponses(extensions, method)
+	responses, err := buildPathItemResponses(extensions, method, options)
 	if err != nil {
 		return nil, err
 	}
 
+	var commentSummary, commentDescription string
+	if m := findProtogenMethodByName(method.GetName(), options.protogenService); m != nil {
+		if text := commentsToText(m.Comments); text != "" {
+			commentSummary, commentDescription = splitSummaryAndDescription(text)
+		}
+	}
+
 	return &Operation{
 		Name:             extensions.HttpMethod(),
-		Description:      extensions.OpenapiMethod.GetDescription(),
-		Summary:          extensions.OpenapiMethod.GetSummary(),
-		Id:               method.GetName(),
+		Description:      chooseDescription(extensions.OpenapiMethod.GetDescription(), commentDescription, options.preferComments),
+		Summary:          chooseDescription(extensions.OpenapiMethod.GetSummary(), commentSummary, options.preferComments),
+		Id:               options.operationIdPrefix + method.GetName(),
 		Tags:             extensions.OpenapiMethod.GetTags(),
+		Deprecated:       deprecated,
+		XSunset:          extensions.OpenapiMethod.GetSunset(),
 		RequestBody:      requestBody,
 		Parameters:       parameters,
 		SecuritySchemes:  securitySchemes,
@@ -2,6 +2,7 @@ package openapi
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	descriptor "google.golang.org/protobuf/types/descriptorpb"
@@ -11,69 +12,119 @@ import (
 )
 
 type Parameter struct {
-	Required    bool
-	Location    string `yaml:"in"`
-	Name        string
-	Description string
-	Schema      *Schema
+	Location    string  `yaml:"in" json:"in"`
+	Name        string  `yaml:"name" json:"name"`
+	Required    bool    `yaml:"required" json:"required"`
+	Description string  `yaml:"description,omitempty" json:"description,omitempty"`
+	Deprecated  bool    `yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
+	XSunset     string  `yaml:"x-sunset,omitempty" json:"x-sunset,omitempty"`
+	Schema      *Schema `yaml:"schema" json:"schema"`
 }
 
 func parseOperationParameters(method *descriptor.MethodDescriptorProto, options *parserOptions, methodExtensions *pocket.MethodExtensions) ([]*Parameter, error) {
 	var (
-		msgName           = trimPackagePath(method.GetInputType())
-		msgSchema         = findProtogenMessageByName(msgName, options.plugin)
+		msgName           = method.GetInputType()
 		parameters        []*Parameter
 		headerMemberNames = getHeaderMemberNames(options.serviceExtensions, methodExtensions)
 	)
 
-	msg := findMessageByName(msgName, options.plugin)
-	if msg == nil {
+	indexed := options.messages.FindByTypeName(msgName)
+	if indexed == nil {
 		return nil, fmt.Errorf("could not find message with name '%s'", msgName)
 	}
+	msg, msgSchema := indexed.proto, indexed.message
+
+	template, err := methodExtensions.PathTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	// Fields of inner messages captured by the path are not query parameters.
+	bound := make(map[string]bool)
+	for _, v := range template.Variables() {
+		bound[v.FieldPath] = true
+	}
 
 	for _, f := range msg.Field {
-		fieldExtensions := pocket.GetFieldExtensions(f)
+		var (
+			fieldExtensions = pocket.GetFieldExtensions(f)
+			location        = methodExtensions.FieldLocation(f.GetName(), fieldExtensions)
+		)
 
 		// We don't need to parse body parameters
-		if fieldExtensions.PropertyLocation() == pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_BODY {
+		if location == pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_BODY {
 			continue
 		}
 
 		schemaOptions := &fieldToSchemaOptions{
+			preferComments:  options.preferComments,
+			fieldNaming:     options.fieldNaming,
 			field:           f,
 			enums:           options.enums,
+			messages:        options.messages,
 			message:         msg,
 			msgSchema:       msgSchema,
 			fieldExtensions: fieldExtensions,
 		}
 
 		if name, schema := fieldToSchema(schemaOptions); schema != nil {
+			// Query parameters cannot hold whole messages, so the fields of
+			// these messages become parameters of their own.
+			if location == pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_QUERY && isMessageField(f, options.messages) {
+				if f.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+					query := &queryMessage{
+						name:     name,
+						path:     f.GetName(),
+						typeName: f.GetTypeName(),
+						bound:    bound,
+						visited:  make(map[string]bool),
+					}
+					parameters = append(parameters, query.parameters(options)...)
+				}
+
+				continue
+			}
+
 			required := schema.IsRequired()
-			if fieldExtensions.PropertyLocation() == pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_PATH {
+			if location == pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_PATH {
 				// The field is always required when it's located at the endpoint
-				// path
+				// path, where it is named after its proto name.
 				required = true
+				name = f.GetName()
 			}
 
-			if headerName, ok := headerMemberNames[name]; ok {
-				delete(headerMemberNames, name)
+			if headerName, ok := headerMemberNames[f.GetName()]; ok {
+				delete(headerMemberNames, f.GetName())
 				name = headerName
 			}
 
 			parameters = append(parameters, &Parameter{
-				Location: toOpenapiLocation(fieldExtensions.PropertyLocation()),
-				Name:     name,
-				Schema: NewSchema(&SchemaOptions{
-					Type:    schema.SchemaType(),
-					Format:  schema.Format,
-					Example: schema.Example,
-				}),
+				Location:    toOpenapiLocation(location),
+				Name:        name,
+				Schema:      parameterSchema(schema, template.Variable(f.GetName())),
 				Required:    required,
 				Description: schema.Description,
+				Deprecated:  schema.Deprecated,
+				XSunset:     schema.XSunset,
 			})
 		}
 	}
 
+	// Variables that capture fields of inner messages, like '{book.id}', are
+	// parameters as well.
+	for _, v := range template.Variables() {
+		if !strings.Contains(v.FieldPath, ".") {
+			continue
+		}
+
+		parameter, err := fieldPathParameter(v, indexed, options)
+		if err != nil {
+			return nil, err
+		}
+
+		parameters = append(parameters, parameter)
+	}
+
 	if len(headerMemberNames) > 0 {
 		return nil, fmt.Errorf("could not find header members '%v' in message '%s'",
 			mapToString(headerMemberNames), msgName)
@@ -82,6 +133,168 @@ func parseOperationParameters(method *descriptor.MethodDescriptorProto, options
 	return parameters, nil
 }
 
+// parameterSchema gives the schema of a parameter from its field schema. Path
+// variables with custom patterns, like '{name=projects/*}', have them added
+// to the schema.
+func parameterSchema(schema *Schema, variable *pocket.PathVariable) *Schema {
+	opts := &SchemaOptions{
+		Type:    schema.SchemaType(),
+		Format:  schema.Format,
+		Pattern: schema.Pattern,
+		Example: schema.Example,
+		Enum:    schema.Enum,
+		Items:   schema.Items,
+
+		AdditionalProperties: schema.AdditionalProperties,
+	}
+
+	if variable != nil && variable.HasPattern() {
+		opts.Pattern = variable.Pattern()
+	}
+
+	s := NewSchema(opts)
+	s.Constraints = schema.Constraints
+
+	return s
+}
+
+// fieldPathParameter builds a path parameter for a variable that captures a
+// field of an inner message.
+func fieldPathParameter(variable *pocket.PathVariable, input *indexedMessage, options *parserOptions) (*Parameter, error) {
+	var (
+		msg   = input
+		parts = strings.Split(variable.FieldPath, ".")
+		field *descriptor.FieldDescriptorProto
+	)
+
+	for i, part := range parts {
+		field = nil
+		for _, f := range msg.proto.Field {
+			if f.GetName() == part {
+				field = f
+				break
+			}
+		}
+
+		if field == nil {
+			return nil, fmt.Errorf("could not find field '%s' of path variable '%s'", part, variable.FieldPath)
+		}
+
+		if i < len(parts)-1 {
+			if msg = options.messages.FindByTypeName(field.GetTypeName()); msg == nil {
+				return nil, fmt.Errorf("field '%s' of path variable '%s' must be a message", part, variable.FieldPath)
+			}
+		}
+	}
+
+	_, schema := fieldToSchema(&fieldToSchemaOptions{
+		preferComments:  options.preferComments,
+		fieldNaming:     options.fieldNaming,
+		field:           field,
+		enums:           options.enums,
+		messages:        options.messages,
+		message:         msg.proto,
+		msgSchema:       msg.message,
+		fieldExtensions: pocket.GetFieldExtensions(field),
+	})
+	if schema == nil {
+		return nil, fmt.Errorf("path variable '%s' cannot use a field hidden from schemas", variable.FieldPath)
+	}
+
+	return &Parameter{
+		Location:    toOpenapiLocation(pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_PATH),
+		Name:        variable.FieldPath,
+		Schema:      parameterSchema(schema, variable),
+		Required:    true,
+		Description: schema.Description,
+		Deprecated:  schema.Deprecated,
+		XSunset:     schema.XSunset,
+	}, nil
+}
+
+// queryMessage is a message field whose fields are query parameters.
+type queryMessage struct {
+	// name is the parameter name of the field and path its proto field path,
+	// like 'filter.name'.
+	name     string
+	path     string
+	typeName string
+
+	// bound holds the field paths captured by the endpoint path.
+	bound   map[string]bool
+	visited map[string]bool
+}
+
+// parameters gives the query parameters of the fields of the message, named
+// after their paths. Repeated message fields cannot be query parameters and
+// are left out.
+func (q *queryMessage) parameters(options *parserOptions) []*Parameter {
+	msg := options.messages.FindByTypeName(q.typeName)
+	if msg == nil || q.visited[q.typeName] {
+		return nil
+	}
+
+	q.visited[q.typeName] = true
+	defer delete(q.visited, q.typeName)
+
+	var parameters []*Parameter
+	for _, f := range msg.proto.Field {
+		path := q.path + "." + f.GetName()
+		if q.bound[path] {
+			continue
+		}
+
+		name, schema := fieldToSchema(&fieldToSchemaOptions{
+			preferComments:  options.preferComments,
+			fieldNaming:     options.fieldNaming,
+			field:           f,
+			enums:           options.enums,
+			messages:        options.messages,
+			message:         msg.proto,
+			msgSchema:       msg.message,
+			fieldExtensions: pocket.GetFieldExtensions(f),
+		})
+		if schema == nil {
+			continue
+		}
+
+		name = q.name + "." + name
+		if isMessageField(f, options.messages) {
+			if f.GetLabel() != descriptor.FieldDescriptorProto_LABEL_REPEATED {
+				inner := *q
+				inner.name, inner.path, inner.typeName = name, path, f.GetTypeName()
+				parameters = append(parameters, inner.parameters(options)...)
+			}
+
+			continue
+		}
+
+		parameters = append(parameters, &Parameter{
+			Location:    toOpenapiLocation(pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_QUERY),
+			Name:        name,
+			Schema:      parameterSchema(schema, nil),
+			Required:    schema.IsRequired(),
+			Description: schema.Description,
+			Deprecated:  schema.Deprecated,
+			XSunset:     schema.XSunset,
+		})
+	}
+
+	return parameters
+}
+
+// isMessageField tells if a field holds messages that are schemas of their
+// own, unlike maps and well-known types, which are objects or have their JSON
+// representation.
+func isMessageField(field *descriptor.FieldDescriptorProto, messages *messageIndex) bool {
+	if field.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE || messages.MapValueField(field) != nil {
+		return false
+	}
+
+	_, ok := wellKnownTypeSchema(field.GetTypeName())
+	return !ok
+}
+
 func getHeaderMemberNames(serviceExtensions *pocket.ServiceExtensions, methodExtensions *pocket.MethodExtensions) map[string]string {
 	var (
 		global = serviceExtensions.GetHeaderMemberNames()
@@ -104,6 +317,7 @@ func mapToString(values map[string]string) string {
 	for k := range values {
 		sl = append(sl, k)
 	}
+	sort.Strings(sl)
 
 	return strings.Join(sl, ", ")
 }
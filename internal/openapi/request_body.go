@@ -2,56 +2,42 @@ package openapi
 
 import (
 	"fmt"
-	"net/http"
 
-	"google.golang.org/protobuf/compiler/protogen"
 	descriptor "google.golang.org/protobuf/types/descriptorpb"
 
 	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/pocket"
 )
 
 type RequestBody struct {
-	Required    bool
-	Description string
-	Content     map[string]*Media
+	Description string            `yaml:"description,omitempty" json:"description,omitempty"`
+	Required    bool              `yaml:"required" json:"required"`
+	Content     map[string]*Media `yaml:"content" json:"content"`
 }
 
-func newRequestBody(method *descriptor.MethodDescriptorProto, plugin *protogen.Plugin, extensions *pocket.MethodExtensions) (*RequestBody, error) {
-	var (
-		required      = extensions.HttpMethod() == http.MethodPost
-		description   = getRequestBodyDescription(method, plugin)
-		refSchemaName string
-	)
-
-	if extensions.HttpMethod() == http.MethodPost {
-		refSchemaName = method.GetInputType()
-	}
-
-	if extensions.HttpMethod() == http.MethodPut {
-		name, err := getRequestBodyRefSchemaNameForPut(method, plugin, extensions)
-		if err != nil {
-			return nil, err
-		}
-		refSchemaName = name
+// newRequestBody builds the request body of an endpoint, following its
+// google.api.http body mapping.
+func newRequestBody(method *descriptor.MethodDescriptorProto, options *parserOptions, extensions *pocket.MethodExtensions) (*RequestBody, error) {
+	schema, err := requestBodySchema(method, options, extensions)
+	if err != nil {
+		return nil, err
 	}
 
 	return &RequestBody{
-		Required:    required,
-		Description: description,
+		Required:    true,
+		Description: getRequestBodyDescription(method, options.messages),
 		Content: map[string]*Media{
-			"application/json": NewMedia(
-				NewSchema(&SchemaOptions{
-					Ref: refComponentsSchemas + trimPackagePath(refSchemaName),
-				}),
-			),
+			"application/json": NewMedia(schema),
 		},
 	}, nil
 }
 
-func getRequestBodyDescription(method *descriptor.MethodDescriptorProto, plugin *protogen.Plugin) string {
-	messageExtensions := pocket.GetMessageExtensions(
-		findMessageByName(trimPackagePath(method.GetInputType()), plugin),
-	)
+func getRequestBodyDescription(method *descriptor.MethodDescriptorProto, messages *messageIndex) string {
+	msg := messages.FindByTypeName(method.GetInputType())
+	if msg == nil {
+		return ""
+	}
+
+	messageExtensions := pocket.GetMessageExtensions(msg.proto)
 
 	if messageExtensions.OpenapiMessage != nil {
 		if op := messageExtensions.OpenapiMessage.GetOperation(); op != nil {
@@ -64,23 +50,46 @@ func getRequestBodyDescription(method *descriptor.MethodDescriptorProto, plugin
 	return ""
 }
 
-func getRequestBodyRefSchemaNameForPut(method *descriptor.MethodDescriptorProto, plugin *protogen.Plugin, extensions *pocket.MethodExtensions) (string, error) {
-	// We shouldn't find a body annotated as "*" but we suport it.
-	if extensions.EndpointDetails.Body == "*" {
-		return method.GetInputType(), nil
+// requestBodySchema gives the schema of a request body, which is the input
+// message for a "*" body or the schema of the field named by the body.
+func requestBodySchema(method *descriptor.MethodDescriptorProto, options *parserOptions, extensions *pocket.MethodExtensions) (*Schema, error) {
+	var (
+		body    = extensions.EndpointDetails.Body
+		msgName = method.GetInputType()
+	)
+
+	if body == "*" {
+		return NewSchema(&SchemaOptions{
+			Ref: refComponentsSchemas + options.messages.SchemaName(msgName),
+		}), nil
 	}
 
-	msgName := trimPackagePath(method.GetInputType())
-	msg := findMessageByName(msgName, plugin)
+	msg := options.messages.FindByTypeName(msgName)
 	if msg == nil {
-		return "", fmt.Errorf("could not find message with name '%s'", msgName)
+		return nil, fmt.Errorf("could not find message with name '%s'", msgName)
 	}
 
-	for _, f := range msg.Field {
-		if f.GetName() == extensions.EndpointDetails.Body {
-			return f.GetTypeName(), nil
+	for _, f := range msg.proto.Field {
+		if f.GetName() != body {
+			continue
+		}
+
+		_, schema := fieldToSchema(&fieldToSchemaOptions{
+			preferComments:  options.preferComments,
+			fieldNaming:     options.fieldNaming,
+			field:           f,
+			enums:           options.enums,
+			messages:        options.messages,
+			message:         msg.proto,
+			msgSchema:       msg.message,
+			fieldExtensions: pocket.GetFieldExtensions(f),
+		})
+		if schema == nil {
+			return nil, fmt.Errorf("request body member '%s' cannot be hidden from schemas", body)
 		}
+
+		return schema, nil
 	}
 
-	return "", fmt.Errorf("could not find member '%s' for the request body", extensions.EndpointDetails.Body)
+	return nil, fmt.Errorf("could not find member '%s' for the request body", body)
 }
@@ -1,132 +1,338 @@
 package openapi
 
 import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
 	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	"gopkg.in/yaml.v2"
 
 	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/pocket"
 	pocketpb "github.com/rsfreitas/protoc-gen-pocket-extensions/options/pocket"
 )
 
 type Response struct {
-	Description string
-	Content     map[string]*Media
+	Description string             `yaml:"description,omitempty" json:"description,omitempty"`
+	Headers     map[string]*Header `yaml:"headers,omitempty" json:"headers,omitempty"`
+	Content     map[string]*Media  `yaml:"content,omitempty" json:"content,omitempty"`
+
+	// Ref points to a response inside the document components. All other
+	// fields are empty when it is set.
+	Ref string `yaml:"$ref,omitempty" json:"$ref,omitempty"`
+}
+
+// Responses holds the responses of an operation by their statuses.
+type Responses map[string]*Response
+
+// MarshalYAML keeps the statuses in the same order as the JSON format,
+// instead of the YAML natural order that places "4XX" before "201".
+func (r Responses) MarshalYAML() (interface{}, error) {
+	statuses := make([]string, 0, len(r))
+	for status := range r {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	responses := make(yaml.MapSlice, 0, len(r))
+	for _, status := range statuses {
+		responses = append(responses, yaml.MapItem{Key: status, Value: r[status]})
+	}
+
+	return responses, nil
+}
+
+// schemas gives all schemas of the response contents and headers.
+func (r *Response) schemas() []*Schema {
+	var schemas []*Schema
+
+	for _, media := range r.Content {
+		schemas = append(schemas, media.Schema)
+	}
+
+	for _, header := range r.Headers {
+		if header.Schema != nil {
+			schemas = append(schemas, header.Schema)
+		}
+	}
+
+	return schemas
 }
 
+// Schemas returns the names of all schemas used by the response.
+func (r *Response) Schemas() []string {
+	var schemas []string
+
+	for _, media := range r.Content {
+		schemas = append(schemas, media.Schema.RefName())
+	}
+
+	return schemas
+}
+
+// defaultResponseDescription is the description of the response of methods
+// that declare no response.
+const defaultResponseDescription = "Success."
+
+// responseStatusRegexp matches the HTTP status codes, or ranges of them, that
+// an OpenAPI response can use as its key, besides "default".
+var responseStatusRegexp = regexp.MustCompile(`^[1-5]([0-9][0-9]|XX)$`)
+
 // buildPathItemResponses builds up all HTTP responses of a protobuf RPC method.
-func buildPathItemResponses(extensions *pocket.MethodExtensions, method *descriptor.MethodDescriptorProto) (map[string]*Response, error) {
-	// containsCode checks inside the method responses for a specific response
-	// code.
-	containsCode := func(code pocketpb.ResponseCode) (int, bool) {
-		for index, res := range extensions.OpenapiMethod.GetResponse() {
-			if res.GetCode() == code {
-				return index, true
+func buildPathItemResponses(extensions *pocket.MethodExtensions, method *descriptor.MethodDescriptorProto, options *parserOptions) (Responses, error) {
+	responses := make(Responses)
+
+	for _, res := range extensions.OpenapiMethod.GetResponse() {
+		status, err := pocket.ResponseStatus(res)
+		if err != nil {
+			return nil, fmt.Errorf("method '%s': %w", method.GetName(), err)
+		}
+
+		if status != "default" && !responseStatusRegexp.MatchString(status) {
+			return nil, fmt.Errorf("method '%s' has an invalid response status '%s'", method.GetName(), status)
+		}
+
+		if _, ok := responses[status]; ok {
+			return nil, fmt.Errorf("method '%s' has more than one response with status '%s'", method.GetName(), status)
+		}
+
+		if res.Ref != nil {
+			if _, ok := options.responses[res.GetRef()]; !ok {
+				return nil, fmt.Errorf("method '%s' uses an unknown shared response '%s'", method.GetName(), res.GetRef())
 			}
+
+			responses[status] = &Response{
+				Ref: refComponentsResponses + res.GetRef(),
+			}
+			continue
 		}
 
-		return 0, false
-	}
+		response, err := buildResponse(res, status, method, options)
+		if err != nil {
+			return nil, fmt.Errorf("method '%s' response '%s': %w", method.GetName(), status, err)
+		}
 
-	var (
-		responses = make(map[string]*Response)
-	)
+		responses[status] = response
+	}
 
-	if index, ok := containsCode(pocketpb.ResponseCode_RESPONSE_CODE_OK); ok {
-		res := extensions.OpenapiMethod.GetResponse()[index]
-		responses[pocket.ResponseCodeToHttpCode(res.GetCode())] = &Response{
-			Description: res.GetDescription(),
+	// Operations must have at least one response, so methods that declare
+	// none answer with their output.
+	if len(responses) == 0 {
+		responses["200"] = &Response{
+			Description: defaultResponseDescription,
 			Content: map[string]*Media{
 				"application/json": NewMedia(
 					NewSchema(&SchemaOptions{
-						Ref: refComponentsSchemas + trimPackagePath(method.GetOutputType()),
+						Ref: refComponentsSchemas + options.messages.SchemaName(method.GetOutputType()),
 					}),
 				),
 			},
 		}
 	}
 
-	if index, ok := containsCode(pocketpb.ResponseCode_RESPONSE_CODE_CREATED); ok {
-		res := extensions.OpenapiMethod.GetResponse()[index]
-		responses[pocket.ResponseCodeToHttpCode(res.GetCode())] = &Response{
-			Description: res.GetDescription(),
-			Content: map[string]*Media{
-				"application/json": NewMedia(
-					NewSchema(&SchemaOptions{
-						Ref: refComponentsSchemas + trimPackagePath(method.GetOutputType()),
-					}),
-				),
-			},
+	return responses, nil
+}
+
+// buildComponentsResponses builds all shared responses of the service.
+func buildComponentsResponses(options *parserOptions) (map[string]*Response, error) {
+	responses := make(map[string]*Response)
+
+	for name, res := range options.responses {
+		response, err := buildResponse(res, "", nil, options)
+		if err != nil {
+			return nil, fmt.Errorf("shared response '%s': %w", name, err)
 		}
+
+		responses[name] = response
 	}
 
-	if index, ok := containsCode(pocketpb.ResponseCode_RESPONSE_CODE_BAD_REQUEST); ok {
-		res := extensions.OpenapiMethod.GetResponse()[index]
-		responses[pocket.ResponseCodeToHttpCode(res.GetCode())] = &Response{
-			Description: res.GetDescription(),
-			Content: map[string]*Media{
-				"application/json": NewMedia(
-					NewSchema(&SchemaOptions{
-						Ref: refComponentsSchemas + "ValidationError",
-					}),
-				),
-			},
+	return responses, nil
+}
+
+// buildResponse builds a response with the status. Its body is the one that
+// it declares or, when it does not declare one, the default body of the
+// status. Shared responses, which have no status nor method, have no default
+// body.
+func buildResponse(res *pocketpb.Response, status string, method *descriptor.MethodDescriptorProto, options *parserOptions) (*Response, error) {
+	if res.GetDescription() == "" {
+		return nil, fmt.Errorf("response must have a description")
+	}
+
+	var schemaName string
+
+	switch {
+	case res.GetNoBody():
+
+	case res.Body != nil:
+		name, err := responseBodySchemaName(res.GetBody(), options)
+		if err != nil {
+			return nil, err
 		}
+		schemaName = name
+
+	case method != nil:
+		schemaName = responseSchemaName(status, method, options.messages)
 	}
 
-	if index, ok := containsCode(pocketpb.ResponseCode_RESPONSE_CODE_UNAUTHORIZED); ok {
-		res := extensions.OpenapiMethod.GetResponse()[index]
-		responses[pocket.ResponseCodeToHttpCode(res.GetCode())] = &Response{
-			Description: res.GetDescription(),
-			Content: map[string]*Media{
-				"application/json": NewMedia(
-					NewSchema(&SchemaOptions{
-						Ref: refComponentsSchemas + "DefaultError",
-					}),
-				),
-			},
+	if len(res.GetExample()) > 0 && schemaName == "" {
+		return nil, fmt.Errorf("response without a body cannot have examples")
+	}
+
+	headers, err := buildResponseHeaders(res.GetHeader(), options)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response{
+		Description: res.GetDescription(),
+		Headers:     headers,
+	}
+
+	if schemaName != "" {
+		media := NewMedia(
+			NewSchema(&SchemaOptions{
+				Ref: refComponentsSchemas + schemaName,
+			}),
+		)
+
+		examples, err := buildResponseExamples(res.GetExample())
+		if err != nil {
+			return nil, err
+		}
+		media.Examples = examples
+
+		response.Content = map[string]*Media{
+			"application/json": media,
 		}
 	}
 
-	if index, ok := containsCode(pocketpb.ResponseCode_RESPONSE_CODE_NOT_FOUND); ok {
-		res := extensions.OpenapiMethod.GetResponse()[index]
-		responses[pocket.ResponseCodeToHttpCode(res.GetCode())] = &Response{
-			Description: res.GetDescription(),
-			Content: map[string]*Media{
-				"application/json": NewMedia(
-					NewSchema(&SchemaOptions{
-						Ref: refComponentsSchemas + "DefaultError",
-					}),
-				),
-			},
+	return response, nil
+}
+
+// buildResponseExamples builds the named examples of a response body from
+// their JSON values.
+func buildResponseExamples(examples []*pocketpb.ResponseExample) (map[string]*Example, error) {
+	if len(examples) == 0 {
+		return nil, nil
+	}
+
+	responseExamples := make(map[string]*Example)
+
+	for _, e := range examples {
+		if _, ok := responseExamples[e.GetName()]; ok {
+			return nil, fmt.Errorf("example '%s' declared more than once", e.GetName())
+		}
+
+		var value interface{}
+		if err := json.Unmarshal([]byte(e.GetValue()), &value); err != nil {
+			return nil, fmt.Errorf("example '%s' must have a JSON value: %w", e.GetName(), err)
+		}
+
+		responseExamples[e.GetName()] = &Example{
+			Summary: e.GetSummary(),
+			Value:   value,
 		}
 	}
 
-	if index, ok := containsCode(pocketpb.ResponseCode_RESPONSE_CODE_PRECONDITION_FAILED); ok {
-		res := extensions.OpenapiMethod.GetResponse()[index]
-		responses[pocket.ResponseCodeToHttpCode(res.GetCode())] = &Response{
-			Description: res.GetDescription(),
-			Content: map[string]*Media{
-				"application/json": NewMedia(
-					NewSchema(&SchemaOptions{
-						Ref: refComponentsSchemas + "DefaultError",
-					}),
-				),
-			},
+	return responseExamples, nil
+}
+
+// responseBodySchemaName gives the schema name of a response body declared
+// by a message name or by the name of an error schema.
+func responseBodySchemaName(name string, options *parserOptions) (string, error) {
+	if name == schemaNameValidationError || name == schemaNameDefaultError {
+		return name, nil
+	}
+
+	typeName, ok := responseBodyTypeName(name, options)
+	if !ok {
+		return "", fmt.Errorf("could not find response body message '%s'", name)
+	}
+
+	return options.messages.SchemaName(typeName), nil
+}
+
+// responseBodyTypeName searches for the message of a response body, first
+// inside the file package and then by its fully-qualified name.
+func responseBodyTypeName(name string, options *parserOptions) (string, bool) {
+	name = strings.TrimPrefix(name, ".")
+	typeNames := []string{
+		"." + options.file.Proto.GetPackage() + "." + name,
+		"." + name,
+	}
+
+	for _, typeName := range typeNames {
+		if options.messages.FindByTypeName(typeName) != nil {
+			return typeName, true
 		}
 	}
 
-	if index, ok := containsCode(pocketpb.ResponseCode_RESPONSE_CODE_INTERNAL_ERROR); ok {
-		res := extensions.OpenapiMethod.GetResponse()[index]
-		responses[pocket.ResponseCodeToHttpCode(res.GetCode())] = &Response{
-			Description: res.GetDescription(),
-			Content: map[string]*Media{
-				"application/json": NewMedia(
-					NewSchema(&SchemaOptions{
-						Ref: refComponentsSchemas + "DefaultError",
-					}),
-				),
-			},
+	return "", false
+}
+
+// responsesTypeNames gives the type names of all messages used as response
+// bodies, by the service methods or by its shared responses.
+func responsesTypeNames(options *parserOptions) []string {
+	var (
+		names     []string
+		responses []*pocketpb.Response
+	)
+
+	for _, method := range options.service.GetMethod() {
+		if ext := pocket.GetMethodExtensions(method); ext != nil {
+			responses = append(responses, ext.OpenapiMethod.GetResponse()...)
 		}
 	}
 
-	return responses, nil
+	for _, res := range options.responses {
+		responses = append(responses, res)
+	}
+
+	for _, res := range responses {
+		if res.Body == nil {
+			continue
+		}
+
+		if typeName, ok := responseBodyTypeName(res.GetBody(), options); ok {
+			names = append(names, typeName)
+		}
+	}
+
+	return names
+}
+
+// responseSchemaName gives the name of the schema of a response body
+// according to its status. Successful responses use the RPC output while
+// failures use one of the error schemas. Informational, redirection and
+// content-less responses, like 204, don't have a body.
+func responseSchemaName(status string, method *descriptor.MethodDescriptorProto, messages *messageIndex) string {
+	if name := errorSchemaName(status); name != "" {
+		return name
+	}
+
+	switch {
+	case status == "204" || status == "205":
+		return ""
+
+	case status[0] == '2':
+		return messages.SchemaName(method.GetOutputType())
+	}
+
+	return ""
+}
+
+// errorSchemaName gives the name of the error schema used by a response with
+// the status, if it is an error one.
+func errorSchemaName(status string) string {
+	switch {
+	case status == "400":
+		return schemaNameValidationError
+
+	case status == "default", status[0] == '4', status[0] == '5':
+		return schemaNameDefaultError
+	}
+
+	return ""
 }
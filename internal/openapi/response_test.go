@@ -0,0 +1,50 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/pocket"
+	pocketpb "github.com/rsfreitas/protoc-gen-pocket-extensions/options/pocket"
+)
+
+func TestBuildPathItemResponses(t *testing.T) {
+	var (
+		options = &parserOptions{messages: newMessageIndex(newTestPlugin(t, usersFiles...))}
+		method  = &descriptor.MethodDescriptorProto{
+			Name:       proto.String("ListUsers"),
+			OutputType: proto.String(".users.v1.Users"),
+		}
+	)
+
+	t.Run("without declared responses", func(t *testing.T) {
+		a := assert.New(t)
+		responses, err := buildPathItemResponses(&pocket.MethodExtensions{}, method, options)
+		a.NoError(err)
+
+		a.Len(responses, 1)
+		a.Equal("Success.", responses["200"].Description)
+		a.Equal("#/components/schemas/Users", responses["200"].Content["application/json"].Schema.Ref)
+	})
+
+	t.Run("with declared responses", func(t *testing.T) {
+		a := assert.New(t)
+		extensions := &pocket.MethodExtensions{
+			OpenapiMethod: &pocketpb.OpenapiMethod{
+				Response: []*pocketpb.Response{
+					{Status: proto.String("204"), Description: proto.String("Listed.")},
+				},
+			},
+		}
+
+		responses, err := buildPathItemResponses(extensions, method, options)
+		a.NoError(err)
+
+		a.Len(responses, 1)
+		a.Equal("Listed.", responses["204"].Description)
+		a.Empty(responses["204"].Content)
+	})
+}
@@ -1,11 +1,8 @@
 package openapi
 
 import (
-	"fmt"
 	"sort"
 	"strings"
-
-	"gopkg.in/yaml.v2"
 )
 
 type SchemaType int
@@ -22,30 +19,69 @@ const (
 
 type SchemaOptions struct {
 	Required    bool
-	Minimum     int
-	Maximum     int
 	Type        SchemaType
 	Format      string
+	Pattern     string
 	Ref         string
 	Description string
 	Example     string
 	Properties  map[string]*Schema
 	Enum        []string
 	Items       *Schema
+
+	// AdditionalProperties sets the schema of the values of an object whose
+	// properties are not known, like a map.
+	AdditionalProperties *Schema
+
+	// OneOf, AnyOf and AllOf hold schemas that a value must match, exactly
+	// one of them, at least one of them or all of them respectively, while
+	// a value must not match the Not schema.
+	OneOf []*Schema
+	AnyOf []*Schema
+	AllOf []*Schema
+	Not   *Schema
+
+	// MaxProperties limits how many properties an object may have.
+	MaxProperties *uint64
+
+	// XOneof groups property names by the protobuf oneof that declares them.
+	XOneof map[string][]string
+
+	// Nullable allows null values besides the ones of the schema type.
+	Nullable bool
+
+	// Deprecated marks the schema as deprecated, and Sunset sets the date
+	// after which it may be removed.
+	Deprecated bool
+	Sunset     string
 }
 
 type Schema struct {
-	Minimum     int                `yaml:"minimum,omitempty"`
-	Maximum     int                `yaml:"maximum,omitempty"`
-	Type        string             `yaml:"type,omitempty"`
-	Format      string             `yaml:"format,omitempty"`
-	Ref         string             `yaml:"$ref,omitempty"`
-	Description string             `yaml:"description,omitempty"`
-	Example     string             `yaml:"example,omitempty"`
-	Items       *Schema            `yaml:"items,omitempty"`
-	Enum        []string           `yaml:"enum,omitempty"`
-	Required    []string           `yaml:"required,omitempty"`
-	Properties  map[string]*Schema `yaml:"properties,omitempty"`
+	Type        interface{}        `yaml:"type,omitempty" json:"type,omitempty"`
+	Nullable    bool               `yaml:"nullable,omitempty" json:"nullable,omitempty"`
+	Const       string             `yaml:"const,omitempty" json:"const,omitempty"`
+	Format      string             `yaml:"format,omitempty" json:"format,omitempty"`
+	Pattern     string             `yaml:"pattern,omitempty" json:"pattern,omitempty"`
+	Ref         string             `yaml:"$ref,omitempty" json:"$ref,omitempty"`
+	Description string             `yaml:"description,omitempty" json:"description,omitempty"`
+	Example     string             `yaml:"example,omitempty" json:"example,omitempty"`
+	Examples    []string           `yaml:"examples,omitempty" json:"examples,omitempty"`
+	Items       *Schema            `yaml:"items,omitempty" json:"items,omitempty"`
+	Enum        []string           `yaml:"enum,omitempty" json:"enum,omitempty"`
+	Required    []string           `yaml:"required,omitempty" json:"required,omitempty"`
+	Properties  map[string]*Schema `yaml:"properties,omitempty" json:"properties,omitempty"`
+
+	AdditionalProperties *Schema             `yaml:"additionalProperties,omitempty" json:"additionalProperties,omitempty"`
+	OneOf                []*Schema           `yaml:"oneOf,omitempty" json:"oneOf,omitempty"`
+	AnyOf                []*Schema           `yaml:"anyOf,omitempty" json:"anyOf,omitempty"`
+	AllOf                []*Schema           `yaml:"allOf,omitempty" json:"allOf,omitempty"`
+	Not                  *Schema             `yaml:"not,omitempty" json:"not,omitempty"`
+	XOneof               map[string][]string `yaml:"x-oneof,omitempty" json:"x-oneof,omitempty"`
+	Deprecated           bool                `yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
+	XSunset              string              `yaml:"x-sunset,omitempty" json:"x-sunset,omitempty"`
+
+	// Constraints holds the validation keywords of the schema.
+	Constraints `yaml:",inline"`
 
 	schemaType SchemaType
 	required   bool
@@ -109,41 +145,32 @@ func (s *Schema) HasRequiredProperty() bool {
 	return false
 }
 
-func (s *Schema) String(prefixSpacing int) string {
+// References gives the names of all schemas referenced by the schema, by its
+// properties and by its inner schemas.
+func (s *Schema) References() []string {
+	var names []string
+
 	if s.Ref != "" {
-		return s.asRef()
+		names = append(names, s.RefName())
 	}
 
-	out, err := yaml.Marshal(s)
-	if err != nil {
-		panic(err.Error())
+	for _, inner := range []*Schema{s.Items, s.AdditionalProperties, s.Not} {
+		if inner != nil {
+			names = append(names, inner.References()...)
+		}
 	}
 
-	prefix := ""
-	for i := 0; i < prefixSpacing; i += 1 {
-		prefix += " "
+	for _, p := range s.Properties {
+		names = append(names, p.References()...)
 	}
 
-	var newLines []string
-	for i, line := range strings.Split(string(out), "\n") {
-		if len(line) == 0 {
-			continue
-		}
-
-		// Skip the first line hoping that the user placed correctly the
-		// cursor for it.
-		if i > 0 {
-			line = prefix + line
+	for _, inners := range [][]*Schema{s.OneOf, s.AnyOf, s.AllOf} {
+		for _, inner := range inners {
+			names = append(names, inner.References()...)
 		}
-
-		newLines = append(newLines, line)
 	}
 
-	return strings.Join(newLines, "\n")
-}
-
-func (s *Schema) asRef() string {
-	return fmt.Sprintf(`$ref: "%s"`, s.Ref)
+	return names
 }
 
 func (s *Schema) RefName() string {
@@ -153,10 +180,9 @@ func (s *Schema) RefName() string {
 
 func NewSchema(options *SchemaOptions) *Schema {
 	s := &Schema{
-		Minimum:     options.Minimum,
-		Maximum:     options.Maximum,
 		schemaType:  options.Type,
 		Format:      options.Format,
+		Pattern:     options.Pattern,
 		Ref:         options.Ref,
 		Description: options.Description,
 		Items:       options.Items,
@@ -164,8 +190,20 @@ func NewSchema(options *SchemaOptions) *Schema {
 		Enum:        options.Enum,
 		Example:     options.Example,
 		required:    options.Required,
+
+		AdditionalProperties: options.AdditionalProperties,
+		OneOf:                options.OneOf,
+		AnyOf:                options.AnyOf,
+		AllOf:                options.AllOf,
+		Not:                  options.Not,
+		XOneof:               options.XOneof,
+		Nullable:             options.Nullable,
+		Deprecated:           options.Deprecated,
+		XSunset:              options.Sunset,
 	}
 
+	s.MaxProperties = options.MaxProperties
+
 	var required []string
 	for name, p := range s.Properties {
 		if p.IsRequired() {
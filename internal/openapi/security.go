@@ -7,46 +7,268 @@ import (
 	pocketpb "github.com/rsfreitas/protoc-gen-pocket-extensions/options/pocket"
 )
 
-func buildSecuritySchemeFromServiceExtensions(serviceExtensions *pocket.ServiceExtensions, tabSize int) string {
-	s := ""
-	attrs := securitySchemeAttrs(serviceExtensions)
-	padding := buildPadding(tabSize)
+// SecurityScheme is an authentication scheme that operations can use.
+type SecurityScheme struct {
+	Type             string      `yaml:"type" json:"type"`
+	Description      string      `yaml:"description,omitempty" json:"description,omitempty"`
+	Name             string      `yaml:"name,omitempty" json:"name,omitempty"`
+	In               string      `yaml:"in,omitempty" json:"in,omitempty"`
+	Scheme           string      `yaml:"scheme,omitempty" json:"scheme,omitempty"`
+	BearerFormat     string      `yaml:"bearerFormat,omitempty" json:"bearerFormat,omitempty"`
+	Flows            *OauthFlows `yaml:"flows,omitempty" json:"flows,omitempty"`
+	OpenIdConnectUrl string      `yaml:"openIdConnectUrl,omitempty" json:"openIdConnectUrl,omitempty"`
+}
+
+// OauthFlows gathers the OAuth2 flows supported by a security scheme.
+type OauthFlows struct {
+	Implicit          *OauthFlow `yaml:"implicit,omitempty" json:"implicit,omitempty"`
+	Password          *OauthFlow `yaml:"password,omitempty" json:"password,omitempty"`
+	ClientCredentials *OauthFlow `yaml:"clientCredentials,omitempty" json:"clientCredentials,omitempty"`
+	AuthorizationCode *OauthFlow `yaml:"authorizationCode,omitempty" json:"authorizationCode,omitempty"`
+}
+
+type OauthFlow struct {
+	AuthorizationUrl string            `yaml:"authorizationUrl,omitempty" json:"authorizationUrl,omitempty"`
+	TokenUrl         string            `yaml:"tokenUrl,omitempty" json:"tokenUrl,omitempty"`
+	RefreshUrl       string            `yaml:"refreshUrl,omitempty" json:"refreshUrl,omitempty"`
+	Scopes           map[string]string `yaml:"scopes" json:"scopes"`
+}
+
+// SecurityRequirements lists the alternative sets of security schemes, by
+// their names and with their scopes, that authorize a request.
+type SecurityRequirements []map[string][]string
 
-	for k, v := range attrs {
-		s += fmt.Sprintf("%v%s: %s\n", padding, k, v)
+// buildComponentsSecuritySchemes gives the security schemes declared by the
+// service, by their names.
+func buildComponentsSecuritySchemes(serviceExtensions *pocket.ServiceExtensions) (map[string]*SecurityScheme, error) {
+	if len(serviceExtensions.SecuritySchemes()) == 0 {
+		return nil, nil
 	}
 
-	return s
+	schemes := make(map[string]*SecurityScheme)
+
+	for _, s := range serviceExtensions.SecuritySchemes() {
+		name := pocket.SecuritySchemeName(s)
+		if _, ok := schemes[name]; ok {
+			return nil, fmt.Errorf("security scheme '%s' declared more than once", name)
+		}
+
+		scheme, err := buildSecurityScheme(s)
+		if err != nil {
+			return nil, fmt.Errorf("security scheme '%s': %w", name, err)
+		}
+
+		schemes[name] = scheme
+	}
+
+	return schemes, nil
 }
 
-func securitySchemeAttrs(serviceExtensions *pocket.ServiceExtensions) map[string]string {
-	attrs := make(map[string]string)
+func buildSecurityScheme(s *pocketpb.HttpSecurityScheme) (*SecurityScheme, error) {
+	scheme := &SecurityScheme{
+		Description: s.GetDescription(),
+	}
 
-	switch serviceExtensions.Service.GetSecurityScheme().GetType() {
+	switch s.GetType() {
 	case pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_HTTP:
-		attrs["type"] = "http"
-
-		httpScheme := httpSchemeToString(serviceExtensions)
-		attrs["scheme"] = httpScheme
+		scheme.Type = "http"
+		scheme.Scheme = httpSchemeToString(s)
 
-		if httpScheme == "bearer" {
-			attrs["bearerFormat"] = bearerFormatToString(serviceExtensions)
+		if scheme.Scheme == "bearer" {
+			scheme.BearerFormat = bearerFormatToString(s)
 		}
 
 	case pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_API_KEY:
-		attrs["name"] = serviceExtensions.Service.GetSecurityScheme().GetName()
-		attrs["in"] = serviceExtensions.Service.GetSecurityScheme().GetIn()
+		if s.GetName() == "" {
+			return nil, fmt.Errorf("apiKey schemes must have a name")
+		}
+
+		switch s.GetIn() {
+		case "query", "header", "cookie":
+		default:
+			return nil, fmt.Errorf("apiKey schemes must be in query, header or cookie")
+		}
+
+		scheme.Type = "apiKey"
+		scheme.Name = s.GetName()
+		scheme.In = s.GetIn()
+
+	case pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_OAUTH2:
+		flows, err := buildOauthFlows(s.GetFlows())
+		if err != nil {
+			return nil, err
+		}
+
+		scheme.Type = "oauth2"
+		scheme.Flows = flows
+
+	case pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_OPEN_ID_CONNECT:
+		if s.GetOpenIdConnectUrl() == "" {
+			return nil, fmt.Errorf("openIdConnect schemes must have an URL")
+		}
+
+		scheme.Type = "openIdConnect"
+		scheme.OpenIdConnectUrl = s.GetOpenIdConnectUrl()
+
+	default:
+		return nil, fmt.Errorf("unsupported type '%s'", s.GetType())
+	}
+
+	return scheme, nil
+}
+
+// buildOauthFlows builds the OAuth2 flows of a scheme, which must declare at
+// least one of them, with the URLs that each flow requires.
+func buildOauthFlows(flows *pocketpb.HttpSecuritySchemeOauthFlows) (*OauthFlows, error) {
+	var (
+		oauthFlows = &OauthFlows{}
+		err        error
+	)
+
+	if flows.GetImplicit() != nil {
+		if oauthFlows.Implicit, err = buildOauthFlow("implicit", flows.GetImplicit(), true, false); err != nil {
+			return nil, err
+		}
+	}
+
+	if flows.GetPassword() != nil {
+		if oauthFlows.Password, err = buildOauthFlow("password", flows.GetPassword(), false, true); err != nil {
+			return nil, err
+		}
+	}
+
+	if flows.GetClientCredentials() != nil {
+		if oauthFlows.ClientCredentials, err = buildOauthFlow("clientCredentials", flows.GetClientCredentials(), false, true); err != nil {
+			return nil, err
+		}
+	}
+
+	if flows.GetAuthorizationCode() != nil {
+		if oauthFlows.AuthorizationCode, err = buildOauthFlow("authorizationCode", flows.GetAuthorizationCode(), true, true); err != nil {
+			return nil, err
+		}
+	}
+
+	if *oauthFlows == (OauthFlows{}) {
+		return nil, fmt.Errorf("oauth2 schemes must have at least one flow")
+	}
+
+	return oauthFlows, nil
+}
+
+func buildOauthFlow(name string, flow *pocketpb.HttpSecuritySchemeOauthFlow, authorizationUrl, tokenUrl bool) (*OauthFlow, error) {
+	if authorizationUrl && flow.GetAuthorizationUrl() == "" {
+		return nil, fmt.Errorf("%s flow must have an authorization URL", name)
+	}
+
+	if tokenUrl && flow.GetTokenUrl() == "" {
+		return nil, fmt.Errorf("%s flow must have a token URL", name)
+	}
+
+	oauthFlow := &OauthFlow{
+		RefreshUrl: flow.GetRefreshUrl(),
+		Scopes:     make(map[string]string),
 	}
 
-	if description := serviceExtensions.Service.GetSecurityScheme().GetDescription(); description != "" {
-		attrs["description"] = description
+	if authorizationUrl {
+		oauthFlow.AuthorizationUrl = flow.GetAuthorizationUrl()
 	}
 
-	return attrs
+	if tokenUrl {
+		oauthFlow.TokenUrl = flow.GetTokenUrl()
+	}
+
+	for _, scope := range flow.GetScope() {
+		oauthFlow.Scopes[scope.GetName()] = scope.GetDescription()
+	}
+
+	return oauthFlow, nil
 }
 
-func httpSchemeToString(serviceExtensions *pocket.ServiceExtensions) string {
-	switch serviceExtensions.Service.GetSecurityScheme().GetScheme() {
+// buildDocumentSecurity gives the default security requirements of the
+// document, where any of the service schemes authorizes a request.
+func buildDocumentSecurity(serviceExtensions *pocket.ServiceExtensions) SecurityRequirements {
+	var requirements SecurityRequirements
+
+	for _, s := range serviceExtensions.SecuritySchemes() {
+		requirements = append(requirements, map[string][]string{
+			pocket.SecuritySchemeName(s): {},
+		})
+	}
+
+	return requirements
+}
+
+// buildSecurityRequirements gives the security requirements of a method,
+// which is nil when the method uses the document ones. Methods that don't
+// require authentication have empty requirements, overriding the document
+// ones, while methods with scopes but without explicit requirements accept
+// any of the service schemes, using the method scopes. Scopes are only kept
+// by OAuth2 and OpenID Connect schemes, since the requirements of other
+// schemes must be empty.
+func buildSecurityRequirements(extensions *pocket.MethodExtensions, serviceExtensions *pocket.ServiceExtensions) (*SecurityRequirements, error) {
+	if !extensions.HasKrillHttpExtension() || len(serviceExtensions.SecuritySchemes()) == 0 {
+		return nil, nil
+	}
+
+	if extensions.Method.GetNoAuth() {
+		return &SecurityRequirements{}, nil
+	}
+
+	schemes := make(map[string]*pocketpb.HttpSecurityScheme)
+	for _, s := range serviceExtensions.SecuritySchemes() {
+		schemes[pocket.SecuritySchemeName(s)] = s
+	}
+
+	var requirements SecurityRequirements
+
+	if len(extensions.Method.GetSecurity()) == 0 {
+		if len(extensions.Method.GetScope()) == 0 {
+			return nil, nil
+		}
+
+		for _, s := range serviceExtensions.SecuritySchemes() {
+			requirements = append(requirements, map[string][]string{
+				pocket.SecuritySchemeName(s): securityScopes(s, extensions.Method.GetScope()),
+			})
+		}
+
+		return &requirements, nil
+	}
+
+	for _, r := range extensions.Method.GetSecurity() {
+		requirement := make(map[string][]string)
+
+		for _, s := range r.GetScheme() {
+			scheme, ok := schemes[s.GetName()]
+			if !ok {
+				return nil, fmt.Errorf("unknown security scheme '%s'", s.GetName())
+			}
+
+			requirement[s.GetName()] = securityScopes(scheme, s.GetScope())
+		}
+
+		requirements = append(requirements, requirement)
+	}
+
+	return &requirements, nil
+}
+
+// securityScopes gives the scopes of a security requirement of a scheme,
+// which are never null.
+func securityScopes(scheme *pocketpb.HttpSecurityScheme, scopes []string) []string {
+	switch scheme.GetType() {
+	case pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_OAUTH2, pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_OPEN_ID_CONNECT:
+		if scopes != nil {
+			return scopes
+		}
+	}
+
+	return []string{}
+}
+
+func httpSchemeToString(scheme *pocketpb.HttpSecurityScheme) string {
+	switch scheme.GetScheme() {
 	case pocketpb.HttpSecuritySchemeScheme_HTTP_SECURITY_SCHEME_SCHEME_BASIC:
 		return "basic"
 	case pocketpb.HttpSecuritySchemeScheme_HTTP_SECURITY_SCHEME_SCHEME_BEARER:
@@ -60,20 +282,11 @@ func httpSchemeToString(serviceExtensions *pocket.ServiceExtensions) string {
 	return "unspecified"
 }
 
-func bearerFormatToString(serviceExtensions *pocket.ServiceExtensions) string {
-	switch serviceExtensions.Service.GetSecurityScheme().GetBearerFormat() {
+func bearerFormatToString(scheme *pocketpb.HttpSecurityScheme) string {
+	switch scheme.GetBearerFormat() {
 	case pocketpb.HttpSecuritySchemeBearerFormat_HTTP_SECURITY_SCHEME_BEARER_FORMAT_JWT:
 		return "jwt"
 	}
 
 	return "unspecified"
 }
-
-func buildPadding(tabSize int) string {
-	s := ""
-	for i := 0; i < tabSize; i++ {
-		s += " "
-	}
-
-	return s
-}
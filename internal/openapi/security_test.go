@@ -0,0 +1,270 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/pocket"
+	pocketpb "github.com/rsfreitas/protoc-gen-pocket-extensions/options/pocket"
+)
+
+func TestBuildSecurityScheme(t *testing.T) {
+	t.Run("oauth2 flows", func(t *testing.T) {
+		scheme, err := buildSecurityScheme(&pocketpb.HttpSecurityScheme{
+			Type: pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_OAUTH2.Enum(),
+			Flows: &pocketpb.HttpSecuritySchemeOauthFlows{
+				Implicit: &pocketpb.HttpSecuritySchemeOauthFlow{
+					AuthorizationUrl: proto.String("https://example.com/authorize"),
+					TokenUrl:         proto.String("https://example.com/token"),
+					Scope: []*pocketpb.HttpSecuritySchemeOauthScope{
+						{Name: proto.String("example:read"), Description: proto.String("Reads examples.")},
+					},
+				},
+				ClientCredentials: &pocketpb.HttpSecuritySchemeOauthFlow{
+					TokenUrl:   proto.String("https://example.com/token"),
+					RefreshUrl: proto.String("https://example.com/refresh"),
+				},
+			},
+		})
+
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal("oauth2", scheme.Type)
+		a.Equal(&OauthFlows{
+			Implicit: &OauthFlow{
+				AuthorizationUrl: "https://example.com/authorize",
+				Scopes:           map[string]string{"example:read": "Reads examples."},
+			},
+			ClientCredentials: &OauthFlow{
+				TokenUrl:   "https://example.com/token",
+				RefreshUrl: "https://example.com/refresh",
+				Scopes:     map[string]string{},
+			},
+		}, scheme.Flows)
+	})
+
+	t.Run("invalid schemes", func(t *testing.T) {
+		for _, test := range []struct {
+			name     string
+			scheme   *pocketpb.HttpSecurityScheme
+			expected string
+		}{
+			{
+				name:     "oauth2 without flows",
+				scheme:   &pocketpb.HttpSecurityScheme{Type: pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_OAUTH2.Enum()},
+				expected: "oauth2 schemes must have at least one flow",
+			},
+			{
+				name: "oauth2 with empty flows",
+				scheme: &pocketpb.HttpSecurityScheme{
+					Type:  pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_OAUTH2.Enum(),
+					Flows: &pocketpb.HttpSecuritySchemeOauthFlows{},
+				},
+				expected: "oauth2 schemes must have at least one flow",
+			},
+			{
+				name: "implicit flow without authorization URL",
+				scheme: &pocketpb.HttpSecurityScheme{
+					Type: pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_OAUTH2.Enum(),
+					Flows: &pocketpb.HttpSecuritySchemeOauthFlows{
+						Implicit: &pocketpb.HttpSecuritySchemeOauthFlow{TokenUrl: proto.String("https://example.com/token")},
+					},
+				},
+				expected: "implicit flow must have an authorization URL",
+			},
+			{
+				name: "password flow without token URL",
+				scheme: &pocketpb.HttpSecurityScheme{
+					Type: pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_OAUTH2.Enum(),
+					Flows: &pocketpb.HttpSecuritySchemeOauthFlows{
+						Password: &pocketpb.HttpSecuritySchemeOauthFlow{},
+					},
+				},
+				expected: "password flow must have a token URL",
+			},
+			{
+				name: "authorization code flow without token URL",
+				scheme: &pocketpb.HttpSecurityScheme{
+					Type: pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_OAUTH2.Enum(),
+					Flows: &pocketpb.HttpSecuritySchemeOauthFlows{
+						AuthorizationCode: &pocketpb.HttpSecuritySchemeOauthFlow{
+							AuthorizationUrl: proto.String("https://example.com/authorize"),
+						},
+					},
+				},
+				expected: "authorizationCode flow must have a token URL",
+			},
+			{
+				name:     "openIdConnect without URL",
+				scheme:   &pocketpb.HttpSecurityScheme{Type: pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_OPEN_ID_CONNECT.Enum()},
+				expected: "openIdConnect schemes must have an URL",
+			},
+			{
+				name: "apiKey without name",
+				scheme: &pocketpb.HttpSecurityScheme{
+					Type: pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_API_KEY.Enum(),
+					In:   proto.String("header"),
+				},
+				expected: "apiKey schemes must have a name",
+			},
+			{
+				name: "apiKey in an unknown location",
+				scheme: &pocketpb.HttpSecurityScheme{
+					Type: pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_API_KEY.Enum(),
+					Name: proto.String("X-Api-Key"),
+					In:   proto.String("body"),
+				},
+				expected: "apiKey schemes must be in query, header or cookie",
+			},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := buildSecurityScheme(test.scheme)
+				assert.EqualError(t, err, test.expected)
+			})
+		}
+	})
+
+	t.Run("openIdConnect and apiKey", func(t *testing.T) {
+		a := assert.New(t)
+
+		scheme, err := buildSecurityScheme(&pocketpb.HttpSecurityScheme{
+			Type:             pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_OPEN_ID_CONNECT.Enum(),
+			OpenIdConnectUrl: proto.String("https://example.com/.well-known/openid-configuration"),
+		})
+		a.NoError(err)
+		a.Equal(&SecurityScheme{
+			Type:             "openIdConnect",
+			OpenIdConnectUrl: "https://example.com/.well-known/openid-configuration",
+		}, scheme)
+
+		scheme, err = buildSecurityScheme(&pocketpb.HttpSecurityScheme{
+			Type: pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_API_KEY.Enum(),
+			Name: proto.String("api_key"),
+			In:   proto.String("query"),
+		})
+		a.NoError(err)
+		a.Equal(&SecurityScheme{Type: "apiKey", Name: "api_key", In: "query"}, scheme)
+	})
+}
+
+func TestBuildSecurityRequirements(t *testing.T) {
+	service := &pocket.ServiceExtensions{
+		Service: &pocketpb.HttpService{
+			SecurityScheme: []*pocketpb.HttpSecurityScheme{
+				{
+					Type:       pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_HTTP.Enum(),
+					Scheme:     pocketpb.HttpSecuritySchemeScheme_HTTP_SECURITY_SCHEME_SCHEME_BEARER.Enum(),
+					SchemeName: proto.String("bearer"),
+				},
+				{
+					Type:       pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_API_KEY.Enum(),
+					Name:       proto.String("X-Api-Key"),
+					In:         proto.String("header"),
+					SchemeName: proto.String("apiKey"),
+				},
+				{
+					Type:             pocketpb.HttpSecuritySchemeType_HTTP_SECURITY_SCHEME_OPEN_ID_CONNECT.Enum(),
+					OpenIdConnectUrl: proto.String("https://auth.example.com/.well-known/openid-configuration"),
+					SchemeName:       proto.String("oidc"),
+				},
+			},
+		},
+	}
+
+	t.Run("named schemes", func(t *testing.T) {
+		requirements, err := buildSecurityRequirements(&pocket.MethodExtensions{
+			Method: &pocketpb.HttpMethod{
+				Security: []*pocketpb.HttpSecurityRequirement{
+					// Both schemes are required together...
+					{
+						Scheme: []*pocketpb.HttpSecurityRequirementScheme{
+							{Name: proto.String("oidc"), Scope: []string{"example:write"}},
+							{Name: proto.String("apiKey")},
+						},
+					},
+					// ...or this one alone.
+					{
+						Scheme: []*pocketpb.HttpSecurityRequirementScheme{
+							{Name: proto.String("apiKey")},
+						},
+					},
+				},
+			},
+		}, service)
+
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal(&SecurityRequirements{
+			{"oidc": {"example:write"}, "apiKey": {}},
+			{"apiKey": {}},
+		}, requirements)
+	})
+
+	t.Run("scopes of other schemes", func(t *testing.T) {
+		requirements, err := buildSecurityRequirements(&pocket.MethodExtensions{
+			Method: &pocketpb.HttpMethod{
+				Security: []*pocketpb.HttpSecurityRequirement{
+					{Scheme: []*pocketpb.HttpSecurityRequirementScheme{{Name: proto.String("bearer"), Scope: []string{"example:write"}}}},
+				},
+			},
+		}, service)
+
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal(&SecurityRequirements{{"bearer": {}}}, requirements)
+	})
+
+	t.Run("scopes without requirements", func(t *testing.T) {
+		requirements, err := buildSecurityRequirements(&pocket.MethodExtensions{
+			Method: &pocketpb.HttpMethod{Scope: []string{"example:read"}},
+		}, service)
+
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal(&SecurityRequirements{
+			{"bearer": {}},
+			{"apiKey": {}},
+			{"oidc": {"example:read"}},
+		}, requirements)
+	})
+
+	t.Run("unknown schemes", func(t *testing.T) {
+		_, err := buildSecurityRequirements(&pocket.MethodExtensions{
+			Method: &pocketpb.HttpMethod{
+				Security: []*pocketpb.HttpSecurityRequirement{
+					{Scheme: []*pocketpb.HttpSecurityRequirementScheme{{Name: proto.String("oauth")}}},
+				},
+			},
+		}, service)
+
+		assert.EqualError(t, err, "unknown security scheme 'oauth'")
+	})
+
+	t.Run("no authentication", func(t *testing.T) {
+		requirements, err := buildSecurityRequirements(&pocket.MethodExtensions{
+			Method: &pocketpb.HttpMethod{NoAuth: proto.Bool(true)},
+		}, service)
+
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal(&SecurityRequirements{}, requirements)
+	})
+
+	t.Run("document requirements", func(t *testing.T) {
+		a := assert.New(t)
+
+		// Methods without HTTP definitions use the document requirements.
+		requirements, err := buildSecurityRequirements(&pocket.MethodExtensions{}, service)
+		a.NoError(err)
+		a.Nil(requirements)
+
+		// Services without schemes have no requirements to override.
+		requirements, err = buildSecurityRequirements(&pocket.MethodExtensions{
+			Method: &pocketpb.HttpMethod{NoAuth: proto.Bool(true)},
+		}, &pocket.ServiceExtensions{Service: &pocketpb.HttpService{}})
+		a.NoError(err)
+		a.Nil(requirements)
+	})
+}
@@ -0,0 +1,149 @@
+package openapi
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type ServicesMode int
+
+const (
+	// ServicesMode_Merged writes the operations of all services of a file
+	// into a single document.
+	ServicesMode_Merged ServicesMode = iota
+
+	// ServicesMode_Split writes a document for each service of a file.
+	ServicesMode_Split
+)
+
+func ParseServicesMode(name string) (ServicesMode, error) {
+	switch name {
+	case "", "merged":
+		return ServicesMode_Merged, nil
+	case "split":
+		return ServicesMode_Split, nil
+	}
+
+	return ServicesMode_Merged, fmt.Errorf("unsupported OpenAPI services mode '%s'", name)
+}
+
+// mergePathItems adds the operations of a service into the ones of other
+// services, which cannot declare the same endpoints.
+func mergePathItems(pathItems, service map[string]map[string]*Operation, serviceName string) error {
+	for endpoint, operations := range service {
+		path, ok := pathItems[endpoint]
+		if !ok {
+			pathItems[endpoint] = operations
+			continue
+		}
+
+		for httpMethod, operation := range operations {
+			if _, exists := path[httpMethod]; exists {
+				return fmt.Errorf("service '%s' declares the endpoint '%s %s' of another service",
+					serviceName, strings.ToUpper(httpMethod), endpoint)
+			}
+
+			path[httpMethod] = operation
+		}
+	}
+
+	return nil
+}
+
+// mergeWebhooks adds the webhooks of a service into the ones of other
+// services, which cannot declare webhooks with the same names.
+func mergeWebhooks(webhooks, service map[string]map[string]*Operation, serviceName string) error {
+	for name, operations := range service {
+		if _, ok := webhooks[name]; ok {
+			return fmt.Errorf("service '%s' declares the webhook '%s' of another service", serviceName, name)
+		}
+
+		webhooks[name] = operations
+	}
+
+	return nil
+}
+
+// mergeComponents adds the components of a service, declared by a file,
+// into the ones of other services, where components can only share their
+// names when they are equal. The files of the schemas are kept by
+// schemaFiles, naming them on conflicts.
+func mergeComponents(components, service *Components, serviceName, fileName string, schemaFiles map[string]string) error {
+	for name, schema := range service.Schemas {
+		if existing, ok := components.Schemas[name]; ok && !reflect.DeepEqual(existing, schema) {
+			return fmt.Errorf("schema '%s' of file '%s' is different from the one of file '%s'", name, fileName, schemaFiles[name])
+		}
+
+		components.Schemas[name] = schema
+		if _, ok := schemaFiles[name]; !ok {
+			schemaFiles[name] = fileName
+		}
+	}
+
+	for name, response := range service.Responses {
+		if existing, ok := components.Responses[name]; ok && !reflect.DeepEqual(existing, response) {
+			return componentConflictError("response", name, serviceName)
+		}
+
+		components.Responses[name] = response
+	}
+
+	for name, header := range service.Headers {
+		if existing, ok := components.Headers[name]; ok && !reflect.DeepEqual(existing, header) {
+			return componentConflictError("header", name, serviceName)
+		}
+
+		components.Headers[name] = header
+	}
+
+	for name, scheme := range service.SecuritySchemes {
+		if existing, ok := components.SecuritySchemes[name]; ok && !reflect.DeepEqual(existing, scheme) {
+			return componentConflictError("security scheme", name, serviceName)
+		}
+
+		components.SecuritySchemes[name] = scheme
+	}
+
+	return nil
+}
+
+func componentConflictError(kind, name, serviceName string) error {
+	return fmt.Errorf("service '%s' declares a %s '%s' different from the one of another service", serviceName, kind, name)
+}
+
+// checkOperationIds validates that all operations of a document, including
+// its webhooks, have unique ids.
+func checkOperationIds(pathItems, webhooks map[string]map[string]*Operation) error {
+	var (
+		endpoints []string
+		ids       = make(map[string]string)
+	)
+
+	for _, items := range []map[string]map[string]*Operation{pathItems, webhooks} {
+		for name, path := range items {
+			for httpMethod, operation := range path {
+				endpoint := strings.ToUpper(httpMethod) + " " + name
+				endpoints = append(endpoints, endpoint)
+				ids[endpoint] = operation.Id
+			}
+		}
+	}
+
+	// Endpoints are sorted so that errors always name them in the same
+	// order.
+	sort.Strings(endpoints)
+
+	operations := make(map[string]string)
+	for _, endpoint := range endpoints {
+		id := ids[endpoint]
+		if existing, ok := operations[id]; ok {
+			return fmt.Errorf("operationId '%s' is used by both '%s' and '%s'", id, existing, endpoint)
+		}
+
+		operations[id] = endpoint
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeComponents(t *testing.T) {
+	newComponents := func(schemas map[string]*Schema) *Components {
+		return &Components{
+			Schemas:         schemas,
+			Responses:       make(map[string]*Response),
+			Headers:         make(map[string]*Header),
+			SecuritySchemes: make(map[string]*SecurityScheme),
+		}
+	}
+
+	var (
+		a           = assert.New(t)
+		components  = newComponents(make(map[string]*Schema))
+		schemaFiles = make(map[string]string)
+		example     = NewSchema(&SchemaOptions{Type: SchemaType_Object})
+	)
+
+	a.NoError(mergeComponents(components, newComponents(map[string]*Schema{"Example": example}), "ExampleService", "example.proto", schemaFiles))
+
+	// Equal schemas are shared.
+	a.NoError(mergeComponents(components, newComponents(map[string]*Schema{"Example": NewSchema(&SchemaOptions{Type: SchemaType_Object})}), "OrderService", "orders.proto", schemaFiles))
+	a.Equal("example.proto", schemaFiles["Example"])
+
+	err := mergeComponents(components, newComponents(map[string]*Schema{"Example": NewSchema(&SchemaOptions{Type: SchemaType_String})}), "OrderService", "orders.proto", schemaFiles)
+	a.EqualError(err, "schema 'Example' of file 'orders.proto' is different from the one of file 'example.proto'")
+}
@@ -1,16 +1,231 @@
 package openapi
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Settings holds additional OpenAPI information that can be loaded from an
+// external file (YAML or JSON), through the plugin 'openapi_settings' option.
+//
+// When present, its values take precedence over the ones declared with the
+// pocket.openapi file annotations: non-empty info fields, contact, license and
+// externalDocs replace their annotated values, and non-empty servers and tags
+// lists replace the annotated ones entirely.
 type Settings struct {
-	Info    *SettingsInfo
-	Servers []*SettingsServer
+	Info         *SettingsInfo         `yaml:"info" json:"info"`
+	Servers      []*SettingsServer     `yaml:"servers" json:"servers"`
+	ExternalDocs *SettingsExternalDocs `yaml:"externalDocs" json:"externalDocs"`
+	Tags         []*SettingsTag        `yaml:"tags" json:"tags"`
 }
 
 type SettingsInfo struct {
-	Title   string
-	Version string
+	Title          string           `yaml:"title" json:"title"`
+	Version        string           `yaml:"version" json:"version"`
+	Description    string           `yaml:"description" json:"description"`
+	TermsOfService string           `yaml:"termsOfService" json:"termsOfService"`
+	Contact        *SettingsContact `yaml:"contact" json:"contact"`
+	License        *SettingsLicense `yaml:"license" json:"license"`
+}
+
+type SettingsContact struct {
+	Name  string `yaml:"name" json:"name"`
+	URL   string `yaml:"url" json:"url"`
+	Email string `yaml:"email" json:"email"`
+}
+
+type SettingsLicense struct {
+	Name       string `yaml:"name" json:"name"`
+	URL        string `yaml:"url" json:"url"`
+	Identifier string `yaml:"identifier" json:"identifier"`
+}
+
+type SettingsExternalDocs struct {
+	URL         string `yaml:"url" json:"url"`
+	Description string `yaml:"description" json:"description"`
+}
+
+type SettingsTag struct {
+	Name         string                `yaml:"name" json:"name"`
+	Description  string                `yaml:"description" json:"description"`
+	ExternalDocs *SettingsExternalDocs `yaml:"externalDocs" json:"externalDocs"`
 }
 
 type SettingsServer struct {
-	URL         string
-	Description string
+	URL         string `yaml:"url" json:"url"`
+	Description string `yaml:"description" json:"description"`
+}
+
+// LoadSettings loads the OpenAPI settings from a file. The file format is
+// chosen by its extension, where '.json' files are parsed as JSON and
+// everything else as YAML. An empty filename gives no settings at all.
+func LoadSettings(filename string) (*Settings, error) {
+	if filename == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not read OpenAPI settings file '%s': %w", filename, err)
+	}
+
+	settings, err := parseSettings(data, strings.ToLower(filepath.Ext(filename)) == ".json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI settings file '%s': %w", filename, err)
+	}
+
+	return settings, nil
+}
+
+func parseSettings(data []byte, isJson bool) (*Settings, error) {
+	var settings Settings
+
+	if isJson {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&settings); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := yaml.UnmarshalStrict(data, &settings); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := settings.validate(); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+func (s *Settings) validate() error {
+	for i, server := range s.Servers {
+		if server == nil || server.URL == "" {
+			return fmt.Errorf("server at index %d must have an 'url'", i)
+		}
+	}
+
+	if s.Info != nil && s.Info.License != nil && s.Info.License.Name == "" {
+		return fmt.Errorf("info license must have a 'name'")
+	}
+
+	if s.ExternalDocs != nil && s.ExternalDocs.URL == "" {
+		return fmt.Errorf("externalDocs must have an 'url'")
+	}
+
+	for i, tag := range s.Tags {
+		if tag == nil || tag.Name == "" {
+			return fmt.Errorf("tag at index %d must have a 'name'", i)
+		}
+
+		if tag.ExternalDocs != nil && tag.ExternalDocs.URL == "" {
+			return fmt.Errorf("externalDocs of tag '%s' must have an 'url'", tag.Name)
+		}
+	}
+
+	return nil
+}
+
+// applyInfo overrides the document Info fields with the ones available in
+// the settings.
+func (s *Settings) applyInfo(info *Info) {
+	if s == nil || s.Info == nil {
+		return
+	}
+
+	if s.Info.Title != "" {
+		info.Title = s.Info.Title
+	}
+
+	if s.Info.Version != "" {
+		info.Version = s.Info.Version
+	}
+
+	if s.Info.Description != "" {
+		info.Description = s.Info.Description
+	}
+
+	if s.Info.TermsOfService != "" {
+		info.TermsOfService = s.Info.TermsOfService
+	}
+
+	if contact := s.Info.Contact; contact != nil {
+		info.Contact = &Contact{
+			Name:  contact.Name,
+			Url:   contact.URL,
+			Email: contact.Email,
+		}
+	}
+
+	if license := s.Info.License; license != nil {
+		info.License = &License{
+			Name:       license.Name,
+			Url:        license.URL,
+			Identifier: license.Identifier,
+		}
+	}
+}
+
+// applyExternalDocs gives the settings external documentation, if any, in
+// place of the one received.
+func (s *Settings) applyExternalDocs(docs *ExternalDocs) *ExternalDocs {
+	if s == nil || s.ExternalDocs == nil {
+		return docs
+	}
+
+	return s.ExternalDocs.externalDocs()
+}
+
+// applyTags gives the settings tags, if any, in place of the ones received.
+func (s *Settings) applyTags(tags []*Tag) []*Tag {
+	if s == nil || len(s.Tags) == 0 {
+		return tags
+	}
+
+	var settingsTags []*Tag
+	for _, tag := range s.Tags {
+		settingsTags = append(settingsTags, &Tag{
+			Name:         tag.Name,
+			Description:  tag.Description,
+			ExternalDocs: tag.ExternalDocs.externalDocs(),
+		})
+	}
+
+	return settingsTags
+}
+
+func (d *SettingsExternalDocs) externalDocs() *ExternalDocs {
+	if d == nil {
+		return nil
+	}
+
+	return &ExternalDocs{
+		Description: d.Description,
+		Url:         d.URL,
+	}
+}
+
+// applyServers gives the settings servers, if any, in place of the ones
+// received.
+func (s *Settings) applyServers(servers []*Server) []*Server {
+	if s == nil || len(s.Servers) == 0 {
+		return servers
+	}
+
+	var settingsServers []*Server
+	for _, server := range s.Servers {
+		settingsServers = append(settingsServers, &Server{
+			Url:         server.URL,
+			Description: server.Description,
+		})
+	}
+
+	return settingsServers
 }
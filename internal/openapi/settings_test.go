@@ -0,0 +1,96 @@
+package openapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeSettings writes a settings file inside a temporary directory, giving
+// its path.
+func writeSettings(t *testing.T, name, content string) string {
+	filename := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(filename, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return filename
+}
+
+func TestLoadSettings(t *testing.T) {
+	t.Run("no file", func(t *testing.T) {
+		settings, err := LoadSettings("")
+
+		a := assert.New(t)
+		a.NoError(err)
+		a.Nil(settings)
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		settings, err := LoadSettings(writeSettings(t, "settings.yaml", `
+info:
+  title: example
+  version: 0.1.0
+servers:
+  - url: https://api.example.com
+    description: Production server.
+`))
+
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal("example", settings.Info.Title)
+		a.Equal("0.1.0", settings.Info.Version)
+		a.Len(settings.Servers, 1)
+		a.Equal("https://api.example.com", settings.Servers[0].URL)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		settings, err := LoadSettings(writeSettings(t, "settings.json",
+			`{"info": {"title": "example"}, "servers": [{"url": "https://api.example.com"}], "tags": [{"name": "examples"}]}`))
+
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal("example", settings.Info.Title)
+		a.Len(settings.Servers, 1)
+		a.Equal("https://api.example.com", settings.Servers[0].URL)
+		a.Len(settings.Tags, 1)
+		a.Equal("examples", settings.Tags[0].Name)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadSettings(filepath.Join(t.TempDir(), "missing.yaml"))
+		assert.ErrorContains(t, err, "could not read OpenAPI settings file")
+	})
+
+	t.Run("bad syntax", func(t *testing.T) {
+		a := assert.New(t)
+
+		_, err := LoadSettings(writeSettings(t, "settings.yaml", "info: [title"))
+		a.ErrorContains(err, "invalid OpenAPI settings file")
+
+		_, err = LoadSettings(writeSettings(t, "settings.json", `{"info": `))
+		a.ErrorContains(err, "invalid OpenAPI settings file")
+	})
+
+	t.Run("unknown keys", func(t *testing.T) {
+		a := assert.New(t)
+
+		_, err := LoadSettings(writeSettings(t, "settings.yaml", "info:\n  titel: example\n"))
+		a.ErrorContains(err, "titel")
+
+		_, err = LoadSettings(writeSettings(t, "settings.json", `{"server": []}`))
+		a.ErrorContains(err, "server")
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		a := assert.New(t)
+
+		_, err := LoadSettings(writeSettings(t, "settings.yaml", "servers:\n  - description: No URL.\n"))
+		a.ErrorContains(err, "server at index 0 must have an 'url'")
+
+		_, err = LoadSettings(writeSettings(t, "settings.yaml", "tags:\n  - description: No name.\n"))
+		a.ErrorContains(err, "tag at index 0 must have a 'name'")
+	})
+}
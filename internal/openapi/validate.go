@@ -0,0 +1,395 @@
+package openapi
+
+import (
+	"math"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Field numbers of the google.protobuf.FieldOptions extensions that hold
+// validation rules. Their messages, buf.validate.FieldConstraints and
+// validate.FieldRules, share the same layout for all rules used here, so
+// they are read from the encoded options without depending on their
+// generated packages.
+const (
+	protovalidateExtensionNumber protowire.Number = 1159 // buf.validate.field
+	pgvExtensionNumber           protowire.Number = 1071 // validate.rules
+)
+
+// Field numbers of the rules of each field type, inside the constraints.
+const (
+	rulesFloat    protowire.Number = 1
+	rulesDouble   protowire.Number = 2
+	rulesInt32    protowire.Number = 3
+	rulesInt64    protowire.Number = 4
+	rulesUint32   protowire.Number = 5
+	rulesUint64   protowire.Number = 6
+	rulesSint32   protowire.Number = 7
+	rulesSint64   protowire.Number = 8
+	rulesFixed32  protowire.Number = 9
+	rulesFixed64  protowire.Number = 10
+	rulesSfixed32 protowire.Number = 11
+	rulesSfixed64 protowire.Number = 12
+	rulesString   protowire.Number = 14
+	rulesEnum     protowire.Number = 16
+	rulesRepeated protowire.Number = 18
+	rulesMap      protowire.Number = 19
+)
+
+// stringFormats are the schema formats of the well-known string rules, by
+// their field numbers. The ip rule has no format of its own and is handled
+// apart.
+var stringFormats = map[protowire.Number]string{
+	12: "email",
+	13: "hostname",
+	15: "ipv4",
+	16: "ipv6",
+	17: "uri",
+	18: "uri-reference",
+	22: "uuid",
+}
+
+// wireValue is a field value of an encoded message.
+type wireValue struct {
+	typ   protowire.Type
+	value uint64
+	bytes []byte
+}
+
+// wireMessage holds the values of an encoded message by their field numbers.
+type wireMessage map[protowire.Number][]wireValue
+
+// parseWireMessage decodes a message without its descriptor. Malformed
+// messages are decoded up to their first error.
+func parseWireMessage(b []byte) wireMessage {
+	m := make(wireMessage)
+
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			break
+		}
+		b = b[n:]
+
+		v := wireValue{typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			v.value, n = protowire.ConsumeVarint(b)
+
+		case protowire.Fixed32Type:
+			var value uint32
+			value, n = protowire.ConsumeFixed32(b)
+			v.value = uint64(value)
+
+		case protowire.Fixed64Type:
+			v.value, n = protowire.ConsumeFixed64(b)
+
+		case protowire.BytesType:
+			v.bytes, n = protowire.ConsumeBytes(b)
+
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			break
+		}
+
+		b = b[n:]
+		m[num] = append(m[num], v)
+	}
+
+	return m
+}
+
+// message gives an embedded message field, merging all of its occurrences.
+func (m wireMessage) message(num protowire.Number) (wireMessage, bool) {
+	var b []byte
+	for _, v := range m[num] {
+		b = append(b, v.bytes...)
+	}
+
+	if len(m[num]) == 0 {
+		return nil, false
+	}
+
+	return parseWireMessage(b), true
+}
+
+// numbers gives all values of a scalar field, including packed ones, using
+// the last one of each occurrence.
+func (m wireMessage) numbers(num protowire.Number, fixedSize int) []uint64 {
+	var values []uint64
+
+	for _, v := range m[num] {
+		if v.typ != protowire.BytesType {
+			values = append(values, v.value)
+			continue
+		}
+
+		for b := v.bytes; len(b) > 0; {
+			var (
+				value uint64
+				n     int
+			)
+
+			switch fixedSize {
+			case 4:
+				var value32 uint32
+				value32, n = protowire.ConsumeFixed32(b)
+				value = uint64(value32)
+			case 8:
+				value, n = protowire.ConsumeFixed64(b)
+			default:
+				value, n = protowire.ConsumeVarint(b)
+			}
+			if n < 0 {
+				break
+			}
+
+			values = append(values, value)
+			b = b[n:]
+		}
+	}
+
+	return values
+}
+
+// number gives the last value of a scalar field.
+func (m wireMessage) number(num protowire.Number) (uint64, bool) {
+	values := m.numbers(num, 0)
+	if len(values) == 0 {
+		return 0, false
+	}
+
+	return values[len(values)-1], true
+}
+
+func (m wireMessage) strings(num protowire.Number) []string {
+	var values []string
+	for _, v := range m[num] {
+		values = append(values, string(v.bytes))
+	}
+
+	return values
+}
+
+// fieldConstraints gives the validation constraints of a field, from its
+// buf.validate or, when it has none, validate.rules options.
+func fieldConstraints(field *descriptor.FieldDescriptorProto) (wireMessage, bool) {
+	if field.GetOptions() == nil {
+		return nil, false
+	}
+
+	// The extensions may be known, when their files are compiled together
+	// with the field, or unknown fields.
+	b, err := proto.Marshal(field.GetOptions())
+	if err != nil {
+		return nil, false
+	}
+
+	options := parseWireMessage(b)
+	for _, num := range []protowire.Number{protovalidateExtensionNumber, pgvExtensionNumber} {
+		if constraints, ok := options.message(num); ok {
+			return constraints, true
+		}
+	}
+
+	return nil, false
+}
+
+// applyFieldConstraints sets the validation rules of a field into its
+// schema. Enum rules use the values of enumType, the type of enum fields.
+func applyFieldConstraints(schema *Schema, constraints wireMessage, enumType string, enums map[string]*protogen.Enum) {
+	if rules, ok := constraints.message(rulesString); ok {
+		applyStringRules(schema, rules)
+	}
+
+	if rules, ok := constraints.message(rulesEnum); ok {
+		applyEnumRules(schema, rules, enums[strings.TrimPrefix(enumType, ".")])
+	}
+
+	if rules, ok := constraints.message(rulesRepeated); ok && schema.Items != nil {
+		if value, ok := rules.number(1); ok {
+			schema.MinItems = value
+		}
+
+		if value, ok := rules.number(2); ok {
+			schema.MaxItems = &value
+		}
+
+		if value, ok := rules.number(3); ok {
+			schema.UniqueItems = value != 0
+		}
+
+		if items, ok := rules.message(4); ok {
+			applyFieldConstraints(schema.Items, items, enumType, enums)
+		}
+	}
+
+	if rules, ok := constraints.message(rulesMap); ok && schema.AdditionalProperties != nil {
+		if value, ok := rules.number(1); ok {
+			schema.MinProperties = value
+		}
+
+		if value, ok := rules.number(2); ok {
+			schema.MaxProperties = &value
+		}
+
+		if values, ok := rules.message(5); ok {
+			applyFieldConstraints(schema.AdditionalProperties, values, enumType, enums)
+		}
+	}
+
+	// 64-bit integers are strings in their JSON form, so numeric bounds only
+	// apply to the other numbers.
+	if schema.SchemaType() != SchemaType_Integer && schema.SchemaType() != SchemaType_Number {
+		return
+	}
+
+	for _, num := range []protowire.Number{
+		rulesFloat, rulesDouble, rulesInt32, rulesInt64, rulesUint32, rulesUint64, rulesSint32,
+		rulesSint64, rulesFixed32, rulesFixed64, rulesSfixed32, rulesSfixed64,
+	} {
+		if rules, ok := constraints.message(num); ok {
+			applyNumberRules(schema, rules, num)
+		}
+	}
+}
+
+func applyStringRules(schema *Schema, rules wireMessage) {
+	if value, ok := rules.number(2); ok {
+		schema.MinLength = value
+	}
+
+	if value, ok := rules.number(3); ok {
+		schema.MaxLength = &value
+	}
+
+	if value, ok := rules.number(19); ok {
+		schema.MinLength = value
+		schema.MaxLength = &value
+	}
+
+	if values := rules.strings(6); len(values) > 0 {
+		schema.Pattern = values[len(values)-1]
+	}
+
+	if values := rules.strings(1); len(values) > 0 {
+		schema.Enum = values[len(values)-1:]
+	}
+
+	if values := rules.strings(10); len(values) > 0 {
+		schema.Enum = values
+	}
+
+	for num, format := range stringFormats {
+		if value, ok := rules.number(num); ok && value != 0 {
+			schema.Format = format
+		}
+	}
+
+	// Addresses of both versions match the ip rule.
+	if value, ok := rules.number(14); ok && value != 0 {
+		schema.AnyOf = []*Schema{
+			NewSchema(&SchemaOptions{Format: "ipv4"}),
+			NewSchema(&SchemaOptions{Format: "ipv6"}),
+		}
+	}
+}
+
+// applyEnumRules restricts the values of an enum schema to the ones accepted
+// by its rules.
+func applyEnumRules(schema *Schema, rules wireMessage, enum *protogen.Enum) {
+	if enum == nil {
+		return
+	}
+
+	var (
+		in    = rules.numbers(3, 0)
+		notIn = make(map[int32]bool)
+	)
+
+	if value, ok := rules.number(1); ok {
+		in = []uint64{value}
+	}
+
+	for _, value := range rules.numbers(4, 0) {
+		notIn[int32(value)] = true
+	}
+
+	if len(in) == 0 && len(notIn) == 0 {
+		return
+	}
+
+	accepted := make(map[int32]bool)
+	for _, value := range in {
+		accepted[int32(value)] = true
+	}
+
+	var values []string
+	for _, v := range enum.Values {
+		number := int32(v.Desc.Number())
+		if (len(in) == 0 || accepted[number]) && !notIn[number] {
+			values = append(values, trimEnumPrefix(enum, v))
+		}
+	}
+
+	schema.Enum = values
+}
+
+// applyNumberRules sets the bounds of a number schema from the rules of a
+// numeric type.
+func applyNumberRules(schema *Schema, rules wireMessage, num protowire.Number) {
+	value := func(field protowire.Number) (float64, bool) {
+		v, ok := rules.number(field)
+		if !ok {
+			return 0, false
+		}
+
+		return decodeNumber(v, num), true
+	}
+
+	if v, ok := value(4); ok {
+		schema.setMinimum(v, true)
+	}
+
+	if v, ok := value(5); ok {
+		schema.setMinimum(v, false)
+	}
+
+	if v, ok := value(2); ok {
+		schema.setMaximum(v, true)
+	}
+
+	if v, ok := value(3); ok {
+		schema.setMaximum(v, false)
+	}
+
+	if v, ok := value(1); ok {
+		schema.setMinimum(v, false)
+		schema.setMaximum(v, false)
+	}
+}
+
+// decodeNumber converts an encoded value of a numeric rules type.
+func decodeNumber(value uint64, num protowire.Number) float64 {
+	switch num {
+	case rulesFloat:
+		return float64(math.Float32frombits(uint32(value)))
+	case rulesDouble:
+		return math.Float64frombits(value)
+	case rulesInt32, rulesInt64, rulesSfixed64:
+		return float64(int64(value))
+	case rulesSint32, rulesSint64:
+		return float64(protowire.DecodeZigZag(value))
+	case rulesSfixed32:
+		return float64(int32(uint32(value)))
+	}
+
+	// uint32, uint64, fixed32 and fixed64
+	return float64(value)
+}
@@ -0,0 +1,324 @@
+package openapi
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protowire"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// statusFile declares the enum used by enum rules.
+const statusFile = `
+name: "status.proto"
+package: "status.v1"
+options { go_package: "example.com/status/v1" }
+enum_type {
+  name: "Status"
+  value { name: "STATUS_UNSPECIFIED" number: 0 }
+  value { name: "STATUS_ACTIVE" number: 1 }
+  value { name: "STATUS_INACTIVE" number: 2 }
+  value { name: "STATUS_DELETED" number: 3 }
+}
+syntax: "proto3"
+`
+
+// Helpers that encode rules the same way protoc does.
+
+func varintField(num protowire.Number, value uint64) []byte {
+	return protowire.AppendVarint(protowire.AppendTag(nil, num, protowire.VarintType), value)
+}
+
+func fixed32Field(num protowire.Number, value uint32) []byte {
+	return protowire.AppendFixed32(protowire.AppendTag(nil, num, protowire.Fixed32Type), value)
+}
+
+func fixed64Field(num protowire.Number, value uint64) []byte {
+	return protowire.AppendFixed64(protowire.AppendTag(nil, num, protowire.Fixed64Type), value)
+}
+
+func stringField(num protowire.Number, value string) []byte {
+	return protowire.AppendString(protowire.AppendTag(nil, num, protowire.BytesType), value)
+}
+
+func packedField(num protowire.Number, values ...uint64) []byte {
+	var b []byte
+	for _, v := range values {
+		b = protowire.AppendVarint(b, v)
+	}
+
+	return protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), b)
+}
+
+func messageField(num protowire.Number, fields ...[]byte) []byte {
+	var b []byte
+	for _, f := range fields {
+		b = append(b, f...)
+	}
+
+	return protowire.AppendBytes(protowire.AppendTag(nil, num, protowire.BytesType), b)
+}
+
+// validatedField gives a field whose options hold the encoded extensions,
+// as unknown fields.
+func validatedField(extensions ...[]byte) *descriptor.FieldDescriptorProto {
+	options := &descriptor.FieldOptions{}
+
+	var b []byte
+	for _, e := range extensions {
+		b = append(b, e...)
+	}
+	options.ProtoReflect().SetUnknown(b)
+
+	return &descriptor.FieldDescriptorProto{Options: options}
+}
+
+// signed gives the two's complement encoding of a signed value.
+func signed(v int64) uint64 {
+	return uint64(v)
+}
+
+func float64Pointer(v float64) *float64 {
+	return &v
+}
+
+func uint64Pointer(v uint64) *uint64 {
+	return &v
+}
+
+func TestApplyFieldConstraints(t *testing.T) {
+	var (
+		plugin = newTestPlugin(t, statusFile)
+		enums  = map[string]*protogen.Enum{
+			"status.v1.Status": plugin.Files[0].Enums[0],
+		}
+	)
+
+	for _, test := range []struct {
+		name     string
+		field    *descriptor.FieldDescriptorProto
+		schema   *SchemaOptions
+		expected *Schema
+	}{
+		{
+			name: "int32 bounds",
+			field: validatedField(messageField(protovalidateExtensionNumber,
+				messageField(rulesInt32, varintField(5, signed(-5)), varintField(2, 10)),
+			)),
+			schema: &SchemaOptions{Type: SchemaType_Integer},
+			expected: &Schema{Constraints: Constraints{
+				Minimum:          float64Pointer(-5),
+				Maximum:          float64Pointer(10),
+				ExclusiveMaximum: true,
+			}},
+		},
+		{
+			name: "sint32 zigzag bounds",
+			field: validatedField(messageField(pgvExtensionNumber,
+				messageField(rulesSint32, varintField(4, protowire.EncodeZigZag(-3)), varintField(3, protowire.EncodeZigZag(7))),
+			)),
+			schema: &SchemaOptions{Type: SchemaType_Integer},
+			expected: &Schema{Constraints: Constraints{
+				Minimum:          float64Pointer(-3),
+				ExclusiveMinimum: true,
+				Maximum:          float64Pointer(7),
+			}},
+		},
+		{
+			name: "sfixed32 const",
+			field: validatedField(messageField(protovalidateExtensionNumber,
+				messageField(rulesSfixed32, fixed32Field(1, uint32(signed(-2)))),
+			)),
+			schema: &SchemaOptions{Type: SchemaType_Integer},
+			expected: &Schema{Constraints: Constraints{
+				Minimum: float64Pointer(-2),
+				Maximum: float64Pointer(-2),
+			}},
+		},
+		{
+			name: "sfixed64 and fixed32 bounds",
+			field: validatedField(messageField(protovalidateExtensionNumber,
+				messageField(rulesSfixed64, fixed64Field(5, signed(-9))),
+				messageField(rulesFixed32, fixed32Field(3, 100)),
+			)),
+			schema: &SchemaOptions{Type: SchemaType_Integer},
+			expected: &Schema{Constraints: Constraints{
+				Minimum: float64Pointer(-9),
+				Maximum: float64Pointer(100),
+			}},
+		},
+		{
+			name: "float and double bounds",
+			field: validatedField(messageField(protovalidateExtensionNumber,
+				messageField(rulesFloat, fixed32Field(4, math.Float32bits(0.5))),
+				messageField(rulesDouble, fixed64Field(3, math.Float64bits(2.5))),
+			)),
+			schema: &SchemaOptions{Type: SchemaType_Number},
+			expected: &Schema{Constraints: Constraints{
+				Minimum:          float64Pointer(0.5),
+				ExclusiveMinimum: true,
+				Maximum:          float64Pointer(2.5),
+			}},
+		},
+		{
+			name: "64-bit integers as strings",
+			field: validatedField(messageField(protovalidateExtensionNumber,
+				messageField(rulesInt64, varintField(5, 1)),
+			)),
+			schema:   &SchemaOptions{Type: SchemaType_String},
+			expected: &Schema{},
+		},
+		{
+			name: "string rules",
+			field: validatedField(messageField(protovalidateExtensionNumber,
+				messageField(rulesString, varintField(2, 2), varintField(3, 8), stringField(6, "^[a-z]+$"), varintField(12, 1)),
+			)),
+			schema: &SchemaOptions{Type: SchemaType_String},
+			expected: &Schema{
+				Format:      "email",
+				Pattern:     "^[a-z]+$",
+				Constraints: Constraints{MinLength: 2, MaxLength: uint64Pointer(8)},
+			},
+		},
+		{
+			name: "ip address",
+			field: validatedField(messageField(protovalidateExtensionNumber,
+				messageField(rulesString, varintField(14, 1)),
+			)),
+			schema: &SchemaOptions{Type: SchemaType_String},
+			expected: &Schema{
+				AnyOf: []*Schema{
+					NewSchema(&SchemaOptions{Format: "ipv4"}),
+					NewSchema(&SchemaOptions{Format: "ipv6"}),
+				},
+			},
+		},
+		{
+			name: "ipv6 address",
+			field: validatedField(messageField(pgvExtensionNumber,
+				messageField(rulesString, varintField(16, 1)),
+			)),
+			schema:   &SchemaOptions{Type: SchemaType_String},
+			expected: &Schema{Format: "ipv6"},
+		},
+		{
+			name: "string len and in",
+			field: validatedField(messageField(pgvExtensionNumber,
+				messageField(rulesString, varintField(19, 4), stringField(10, "abcd"), stringField(10, "efgh")),
+			)),
+			schema: &SchemaOptions{Type: SchemaType_String},
+			expected: &Schema{
+				Enum:        []string{"abcd", "efgh"},
+				Constraints: Constraints{MinLength: 4, MaxLength: uint64Pointer(4)},
+			},
+		},
+		{
+			name: "merged occurrences",
+			field: validatedField(
+				messageField(protovalidateExtensionNumber, messageField(rulesString, varintField(2, 2))),
+				messageField(protovalidateExtensionNumber, messageField(rulesString, varintField(3, 5))),
+			),
+			schema:   &SchemaOptions{Type: SchemaType_String},
+			expected: &Schema{Constraints: Constraints{MinLength: 2, MaxLength: uint64Pointer(5)}},
+		},
+		{
+			name: "buf.validate before validate.rules",
+			field: validatedField(
+				messageField(pgvExtensionNumber, messageField(rulesString, varintField(2, 1))),
+				messageField(protovalidateExtensionNumber, messageField(rulesString, varintField(2, 3))),
+			),
+			schema:   &SchemaOptions{Type: SchemaType_String},
+			expected: &Schema{Constraints: Constraints{MinLength: 3}},
+		},
+		{
+			name: "repeated rules",
+			field: validatedField(messageField(protovalidateExtensionNumber,
+				messageField(rulesRepeated,
+					varintField(1, 1),
+					varintField(2, 3),
+					varintField(3, 1),
+					messageField(4, messageField(rulesString, varintField(2, 1))),
+				),
+			)),
+			schema: &SchemaOptions{Type: SchemaType_Array, Items: NewSchema(&SchemaOptions{Type: SchemaType_String})},
+			expected: &Schema{
+				Items: &Schema{Constraints: Constraints{MinLength: 1}},
+				Constraints: Constraints{
+					MinItems:    1,
+					MaxItems:    uint64Pointer(3),
+					UniqueItems: true,
+				},
+			},
+		},
+		{
+			name: "map rules",
+			field: validatedField(messageField(pgvExtensionNumber,
+				messageField(rulesMap,
+					varintField(2, 0),
+					messageField(5, messageField(rulesInt32, varintField(4, 0))),
+				),
+			)),
+			schema: &SchemaOptions{Type: SchemaType_Object, AdditionalProperties: NewSchema(&SchemaOptions{Type: SchemaType_Integer})},
+			expected: &Schema{
+				AdditionalProperties: &Schema{Constraints: Constraints{
+					Minimum:          float64Pointer(0),
+					ExclusiveMinimum: true,
+				}},
+				Constraints: Constraints{MaxProperties: uint64Pointer(0)},
+			},
+		},
+		{
+			name: "packed enum in",
+			field: validatedField(messageField(protovalidateExtensionNumber,
+				messageField(rulesEnum, packedField(3, 1, 2)),
+			)),
+			schema:   &SchemaOptions{Type: SchemaType_String},
+			expected: &Schema{Enum: []string{"ACTIVE", "INACTIVE"}},
+		},
+		{
+			name: "enum not in",
+			field: validatedField(messageField(pgvExtensionNumber,
+				messageField(rulesEnum, varintField(4, 0), varintField(4, 3)),
+			)),
+			schema:   &SchemaOptions{Type: SchemaType_String},
+			expected: &Schema{Enum: []string{"ACTIVE", "INACTIVE"}},
+		},
+		{
+			name: "enum const",
+			field: validatedField(messageField(protovalidateExtensionNumber,
+				messageField(rulesEnum, varintField(1, 3)),
+			)),
+			schema:   &SchemaOptions{Type: SchemaType_String},
+			expected: &Schema{Enum: []string{"DELETED"}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			constraints, ok := fieldConstraints(test.field)
+
+			a := assert.New(t)
+			a.True(ok)
+
+			schema := NewSchema(test.schema)
+			applyFieldConstraints(schema, constraints, ".status.v1.Status", enums)
+
+			a.Equal(test.expected.Format, schema.Format)
+			a.Equal(test.expected.AnyOf, schema.AnyOf)
+			a.Equal(test.expected.Pattern, schema.Pattern)
+			a.Equal(test.expected.Enum, schema.Enum)
+			a.Equal(test.expected.Constraints, schema.Constraints)
+			if test.expected.Items != nil {
+				a.Equal(test.expected.Items.Constraints, schema.Items.Constraints)
+			}
+			if test.expected.AdditionalProperties != nil {
+				a.Equal(test.expected.AdditionalProperties.Constraints, schema.AdditionalProperties.Constraints)
+			}
+		})
+	}
+
+	t.Run("without constraints", func(t *testing.T) {
+		_, ok := fieldConstraints(&descriptor.FieldDescriptorProto{Options: &descriptor.FieldOptions{}})
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,76 @@
+package openapi
+
+import (
+	"fmt"
+)
+
+type Version int
+
+const (
+	// Version_3_0 builds OpenAPI 3.0 documents.
+	Version_3_0 Version = iota
+
+	// Version_3_1 builds OpenAPI 3.1 documents, whose schemas follow the
+	// JSON Schema 2020-12 semantics.
+	Version_3_1
+)
+
+// jsonSchemaDialect is the default schema dialect of OpenAPI 3.1 documents.
+const jsonSchemaDialect = "https://spec.openapis.org/oas/3.1/dialect/base"
+
+func ParseVersion(name string) (Version, error) {
+	switch name {
+	case "", "3.0":
+		return Version_3_0, nil
+	case "3.1":
+		return Version_3_1, nil
+	}
+
+	return Version_3_0, fmt.Errorf("unsupported OpenAPI version '%s'", name)
+}
+
+// String gives the version written into documents.
+func (v Version) String() string {
+	if v == Version_3_1 {
+		return "3.1.0"
+	}
+
+	return "3.0.3"
+}
+
+// toVersion31 converts a schema, and all its inner schemas, from OpenAPI 3.0
+// constructs into their JSON Schema 2020-12 equivalents.
+func (s *Schema) toVersion31() {
+	if s.Nullable {
+		s.Type = []string{s.schemaType.String(), "null"}
+		s.Nullable = false
+	}
+
+	if s.Example != "" {
+		s.Examples = []string{s.Example}
+		s.Example = ""
+	}
+
+	s.Constraints.toVersion31()
+
+	if len(s.Enum) == 1 {
+		s.Const = s.Enum[0]
+		s.Enum = nil
+	}
+
+	for _, inner := range []*Schema{s.Items, s.AdditionalProperties, s.Not} {
+		if inner != nil {
+			inner.toVersion31()
+		}
+	}
+
+	for _, p := range s.Properties {
+		p.toVersion31()
+	}
+
+	for _, inners := range [][]*Schema{s.OneOf, s.AnyOf, s.AllOf} {
+		for _, inner := range inners {
+			inner.toVersion31()
+		}
+	}
+}
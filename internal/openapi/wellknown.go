@@ -0,0 +1,111 @@
+package openapi
+
+import (
+	"strings"
+
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// durationPattern matches a google.protobuf.Duration JSON value, like "1.5s".
+const durationPattern = `^-?[0-9]+(\.[0-9]{1,9})?s$`
+
+// scalarTypeSchema gives the schema options of a protobuf scalar type, as the
+// proto3 JSON mapping puts it on the wire. 64-bit integers, for example, are
+// encoded as strings, and bytes as base64 strings.
+func scalarTypeSchema(fieldType descriptor.FieldDescriptorProto_Type) *SchemaOptions {
+	switch fieldType {
+	case descriptor.FieldDescriptorProto_TYPE_STRING, descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return &SchemaOptions{Type: SchemaType_String}
+
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return &SchemaOptions{Type: SchemaType_Bool}
+
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE:
+		return &SchemaOptions{Type: SchemaType_Number, Format: "double"}
+
+	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return &SchemaOptions{Type: SchemaType_Number, Format: "float"}
+
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return &SchemaOptions{Type: SchemaType_String, Format: "byte"}
+
+	case descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return &SchemaOptions{Type: SchemaType_String, Format: "int64"}
+
+	case descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return &SchemaOptions{Type: SchemaType_String, Format: "uint64"}
+
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return &SchemaOptions{Type: SchemaType_Integer, Format: "int64"}
+	}
+
+	// int32, sint32 and sfixed32
+	return &SchemaOptions{Type: SchemaType_Integer, Format: "int32"}
+}
+
+// wrapperTypes are the scalar types of all google.protobuf wrapper types.
+var wrapperTypes = map[string]descriptor.FieldDescriptorProto_Type{
+	"google.protobuf.DoubleValue": descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+	"google.protobuf.FloatValue":  descriptor.FieldDescriptorProto_TYPE_FLOAT,
+	"google.protobuf.Int64Value":  descriptor.FieldDescriptorProto_TYPE_INT64,
+	"google.protobuf.UInt64Value": descriptor.FieldDescriptorProto_TYPE_UINT64,
+	"google.protobuf.Int32Value":  descriptor.FieldDescriptorProto_TYPE_INT32,
+	"google.protobuf.UInt32Value": descriptor.FieldDescriptorProto_TYPE_UINT32,
+	"google.protobuf.BoolValue":   descriptor.FieldDescriptorProto_TYPE_BOOL,
+	"google.protobuf.StringValue": descriptor.FieldDescriptorProto_TYPE_STRING,
+	"google.protobuf.BytesValue":  descriptor.FieldDescriptorProto_TYPE_BYTES,
+}
+
+// wellKnownTypeSchema gives the schema options of a google.protobuf well-known
+// type, as the proto3 JSON mapping puts it on the wire. It returns false if
+// the type is not a well-known one.
+func wellKnownTypeSchema(typeName string) (*SchemaOptions, bool) {
+	name := strings.TrimPrefix(typeName, ".")
+
+	if scalarType, ok := wrapperTypes[name]; ok {
+		// Wrappers are the nullable version of their scalar types.
+		opts := scalarTypeSchema(scalarType)
+		opts.Nullable = true
+		return opts, true
+	}
+
+	switch name {
+	case "google.protobuf.Timestamp":
+		return &SchemaOptions{Type: SchemaType_String, Format: "date-time"}, true
+
+	case "google.protobuf.Duration":
+		return &SchemaOptions{Type: SchemaType_String, Pattern: durationPattern}, true
+
+	case "google.protobuf.FieldMask":
+		// Field paths, in lowerCamelCase, separated by commas.
+		return &SchemaOptions{Type: SchemaType_String}, true
+
+	case "google.protobuf.Struct", "google.protobuf.Empty":
+		// Objects without declared properties accept any of them.
+		return &SchemaOptions{Type: SchemaType_Object}, true
+
+	case "google.protobuf.Value":
+		// Any JSON value, which is represented by a schema without a type.
+		return &SchemaOptions{}, true
+
+	case "google.protobuf.ListValue":
+		return &SchemaOptions{
+			Type:  SchemaType_Array,
+			Items: NewSchema(&SchemaOptions{}),
+		}, true
+
+	case "google.protobuf.Any":
+		return &SchemaOptions{
+			Type: SchemaType_Object,
+			Properties: map[string]*Schema{
+				"@type": NewSchema(&SchemaOptions{
+					Type:     SchemaType_String,
+					Required: true,
+				}),
+			},
+		}, true
+	}
+
+	return nil, false
+}
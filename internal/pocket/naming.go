@@ -0,0 +1,86 @@
+package pocket
+
+import (
+	"fmt"
+	"strings"
+
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// FieldNaming sets how message fields are named in their JSON form.
+type FieldNaming int
+
+const (
+	// FieldNaming_Proto uses the field name as declared in the .proto file.
+	FieldNaming_Proto FieldNaming = iota
+
+	// FieldNaming_JsonName uses the field json_name, which protoc sets to
+	// the lowerCamelCase field name when it is not declared.
+	FieldNaming_JsonName
+
+	// FieldNaming_CamelCase uses the lowerCamelCase field name, ignoring any
+	// declared json_name.
+	FieldNaming_CamelCase
+)
+
+func ParseFieldNaming(name string) (FieldNaming, error) {
+	switch name {
+	case "", "proto":
+		return FieldNaming_Proto, nil
+	case "json_name":
+		return FieldNaming_JsonName, nil
+	case "camel":
+		return FieldNaming_CamelCase, nil
+	}
+
+	return FieldNaming_Proto, fmt.Errorf("unsupported field naming '%s'", name)
+}
+
+// FieldName gives the name of a field in its JSON form. A field with a
+// database name is always named after it, since it is also used for the
+// serialization of the field.
+func (n FieldNaming) FieldName(field *descriptor.FieldDescriptorProto) string {
+	if ext := GetFieldExtensions(field); ext.Database != nil && ext.Database.GetName() != "" {
+		return ext.Database.GetName()
+	}
+
+	switch n {
+	case FieldNaming_JsonName:
+		if field.GetJsonName() != "" {
+			return field.GetJsonName()
+		}
+
+		return lowerCamelCase(field.GetName())
+
+	case FieldNaming_CamelCase:
+		return lowerCamelCase(field.GetName())
+	}
+
+	return field.GetName()
+}
+
+// lowerCamelCase converts a field name the same way protoc does when it
+// builds the default json_name of a field: underscores are removed and the
+// letters following them are capitalized.
+func lowerCamelCase(name string) string {
+	var (
+		b         strings.Builder
+		upperNext = false
+	)
+
+	for _, c := range name {
+		if c == '_' {
+			upperNext = true
+			continue
+		}
+
+		if upperNext && c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+
+		upperNext = false
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
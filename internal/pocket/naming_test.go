@@ -0,0 +1,31 @@
+package pocket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestFieldNaming(t *testing.T) {
+	field := &descriptor.FieldDescriptorProto{
+		Name:     proto.String("page_size"),
+		JsonName: proto.String("limit"),
+	}
+
+	t.Run("proto names", func(t *testing.T) {
+		assert.Equal(t, "page_size", FieldNaming_Proto.FieldName(field))
+	})
+
+	t.Run("json names", func(t *testing.T) {
+		assert.Equal(t, "limit", FieldNaming_JsonName.FieldName(field))
+	})
+
+	t.Run("camel case names", func(t *testing.T) {
+		a := assert.New(t)
+		a.Equal("pageSize", FieldNaming_CamelCase.FieldName(field))
+		a.Equal("field1A", lowerCamelCase("field_1_a"))
+		a.Equal("fooBar", lowerCamelCase("foo__bar"))
+	})
+}
@@ -0,0 +1,288 @@
+package pocket
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PathTemplate is a google.api.http path template, with the syntax:
+//
+//	Template = "/" Segments [ Verb ] ;
+//	Segments = Segment { "/" Segment } ;
+//	Segment  = "*" | "**" | LITERAL | Variable ;
+//	Variable = "{" FieldPath [ "=" Segments ] "}" ;
+//	FieldPath = IDENT { "." IDENT } ;
+//	Verb     = ":" LITERAL ;
+type PathTemplate struct {
+	Segments []*PathSegment
+	Verb     string
+}
+
+// PathSegment is a single segment of a path template. It holds either a
+// literal value (which may also be a '*' or a '**' wildcard) or a variable.
+type PathSegment struct {
+	Literal  string
+	Variable *PathVariable
+}
+
+// PathVariable is a variable of a path template, capturing the value of a
+// request message field.
+type PathVariable struct {
+	// FieldPath is the path of the field that receives the captured value,
+	// like 'id' or 'book.id'.
+	FieldPath string
+
+	// Segments is the pattern that the captured value must match. It is a
+	// single '*' when the variable does not declare one.
+	Segments []string
+}
+
+var fieldPathRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z_0-9]*(\.[A-Za-z_][A-Za-z_0-9]*)*$`)
+
+// ParsePathTemplate parses a google.api.http path template.
+func ParsePathTemplate(template string) (*PathTemplate, error) {
+	if !strings.HasPrefix(template, "/") {
+		return nil, fmt.Errorf("path template '%s' must start with '/'", template)
+	}
+
+	var (
+		path = template[1:]
+		verb string
+	)
+
+	// The verb can only be found after the last segment, outside any
+	// variable.
+	if index := lastIndexOutsideBraces(path, ':'); index > lastIndexOutsideBraces(path, '/') {
+		path, verb = path[:index], path[index+1:]
+		if verb == "" {
+			return nil, fmt.Errorf("path template '%s' has an empty verb", template)
+		}
+	}
+
+	parts, err := splitOutsideBraces(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path template '%s': %w", template, err)
+	}
+
+	pathTemplate := &PathTemplate{
+		Verb: verb,
+	}
+
+	for i, part := range parts {
+		segment, err := parsePathSegment(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path template '%s': %w", template, err)
+		}
+
+		if segment.hasDoubleWildcard() && i != len(parts)-1 {
+			return nil, fmt.Errorf("invalid path template '%s': '**' must be at its last segment", template)
+		}
+
+		pathTemplate.Segments = append(pathTemplate.Segments, segment)
+	}
+
+	return pathTemplate, nil
+}
+
+func parsePathSegment(segment string) (*PathSegment, error) {
+	if segment == "" {
+		return nil, fmt.Errorf("empty segment")
+	}
+
+	if !strings.HasPrefix(segment, "{") {
+		if strings.ContainsAny(segment, "{}") {
+			return nil, fmt.Errorf("segment '%s' has unexpected braces", segment)
+		}
+
+		return &PathSegment{Literal: segment}, nil
+	}
+
+	if !strings.HasSuffix(segment, "}") {
+		return nil, fmt.Errorf("variable '%s' must end with '}'", segment)
+	}
+
+	var (
+		content          = segment[1 : len(segment)-1]
+		fieldPath, value = content, "*"
+	)
+
+	if index := strings.Index(content, "="); index >= 0 {
+		fieldPath, value = content[:index], content[index+1:]
+	}
+
+	if !fieldPathRegexp.MatchString(fieldPath) {
+		return nil, fmt.Errorf("invalid field path '%s'", fieldPath)
+	}
+
+	variable := &PathVariable{
+		FieldPath: fieldPath,
+	}
+
+	patterns := strings.Split(value, "/")
+	for i, s := range patterns {
+		if s == "" || strings.ContainsAny(s, "{}") {
+			return nil, fmt.Errorf("invalid pattern '%s' for variable '%s'", value, fieldPath)
+		}
+		if s == "**" && i != len(patterns)-1 {
+			return nil, fmt.Errorf("'**' must be at the last segment of the pattern '%s' of variable '%s'", value, fieldPath)
+		}
+
+		variable.Segments = append(variable.Segments, s)
+	}
+
+	return &PathSegment{Variable: variable}, nil
+}
+
+// IsMultiSegment returns true if the segment may match more than a single
+// path segment.
+func (s *PathSegment) IsMultiSegment() bool {
+	if s.Variable != nil {
+		return s.Variable.IsMultiSegment()
+	}
+
+	return s.Literal == "**"
+}
+
+// hasDoubleWildcard returns true if the segment is, or captures, a '**'
+// wildcard, which can only be used by the last template segment.
+func (s *PathSegment) hasDoubleWildcard() bool {
+	if s.Variable != nil {
+		return s.Variable.Segments[len(s.Variable.Segments)-1] == "**"
+	}
+
+	return s.Literal == "**"
+}
+
+// IsMultiSegment returns true if the variable may capture more than a single
+// path segment.
+func (v *PathVariable) IsMultiSegment() bool {
+	return len(v.Segments) > 1 || v.Segments[0] == "**"
+}
+
+// HasPattern returns true if the variable declares a pattern other than the
+// default '*' one.
+func (v *PathVariable) HasPattern() bool {
+	return len(v.Segments) > 1 || v.Segments[0] != "*"
+}
+
+// Pattern gives a regular expression that matches the values captured by the
+// variable.
+func (v *PathVariable) Pattern() string {
+	var parts []string
+
+	for _, s := range v.Segments {
+		switch s {
+		case "*":
+			parts = append(parts, "[^/]+")
+		case "**":
+			parts = append(parts, ".+")
+		default:
+			parts = append(parts, regexp.QuoteMeta(s))
+		}
+	}
+
+	return "^" + strings.Join(parts, "/") + "$"
+}
+
+// Variables gives all variables declared inside the template.
+func (t *PathTemplate) Variables() []*PathVariable {
+	var variables []*PathVariable
+
+	for _, s := range t.Segments {
+		if s.Variable != nil {
+			variables = append(variables, s.Variable)
+		}
+	}
+
+	return variables
+}
+
+// Variable searches for a template variable using its field path.
+func (t *PathTemplate) Variable(fieldPath string) *PathVariable {
+	for _, v := range t.Variables() {
+		if v.FieldPath == fieldPath {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// OpenapiPath converts the template into an OpenAPI path, where every
+// variable becomes a single parameter named after its field path.
+func (t *PathTemplate) OpenapiPath() string {
+	var parts []string
+
+	for _, s := range t.Segments {
+		if s.Variable != nil {
+			parts = append(parts, "{"+s.Variable.FieldPath+"}")
+			continue
+		}
+
+		parts = append(parts, s.Literal)
+	}
+
+	path := "/" + strings.Join(parts, "/")
+	if t.Verb != "" {
+		path += ":" + t.Verb
+	}
+
+	return path
+}
+
+func lastIndexOutsideBraces(s string, c byte) int {
+	var (
+		depth = 0
+		index = -1
+	)
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case c:
+			if depth == 0 {
+				index = i
+			}
+		}
+	}
+
+	return index
+}
+
+func splitOutsideBraces(s string) ([]string, error) {
+	var (
+		parts []string
+		depth = 0
+		start = 0
+	)
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+			if depth > 1 {
+				return nil, fmt.Errorf("nested variables are not supported")
+			}
+		case '}':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced braces")
+			}
+		case '/':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced braces")
+	}
+
+	return append(parts, s[start:]), nil
+}
@@ -0,0 +1,77 @@
+package pocket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePathTemplate(t *testing.T) {
+	t.Run("simple variables", func(t *testing.T) {
+		template, err := ParsePathTemplate("/v1/books/{id}")
+
+		a := assert.New(t)
+		a.Nil(err)
+		a.Equal("/v1/books/{id}", template.OpenapiPath())
+		a.Equal(1, len(template.Variables()))
+		a.False(template.Variable("id").HasPattern())
+	})
+
+	t.Run("multi-segment captures", func(t *testing.T) {
+		template, err := ParsePathTemplate("/v1/{name=projects/*/books/*}")
+
+		a := assert.New(t)
+		a.Nil(err)
+		a.Equal("/v1/{name}", template.OpenapiPath())
+		a.True(template.Variable("name").IsMultiSegment())
+		a.Equal("^projects/[^/]+/books/[^/]+$", template.Variable("name").Pattern())
+	})
+
+	t.Run("field paths and custom verbs", func(t *testing.T) {
+		template, err := ParsePathTemplate("/v1/{book.id}:publish")
+
+		a := assert.New(t)
+		a.Nil(err)
+		a.Equal("publish", template.Verb)
+		a.Equal("/v1/{book.id}:publish", template.OpenapiPath())
+		a.NotNil(template.Variable("book.id"))
+	})
+
+	t.Run("double wildcard", func(t *testing.T) {
+		template, err := ParsePathTemplate("/v1/{path=**}")
+
+		a := assert.New(t)
+		a.Nil(err)
+		a.True(template.Variable("path").IsMultiSegment())
+		a.Equal("^.+$", template.Variable("path").Pattern())
+	})
+
+	t.Run("multi-segment captures before other segments", func(t *testing.T) {
+		template, err := ParsePathTemplate("/v1/{parent=publishers/*}/books")
+
+		a := assert.New(t)
+		a.Nil(err)
+		a.Equal("/v1/{parent}/books", template.OpenapiPath())
+		a.True(template.Variable("parent").IsMultiSegment())
+		a.Equal("^publishers/[^/]+$", template.Variable("parent").Pattern())
+	})
+
+	t.Run("invalid templates", func(t *testing.T) {
+		a := assert.New(t)
+		for _, template := range []string{
+			"v1/books",
+			"/v1/{id",
+			"/v1/{id}}",
+			"/v1/{1id}",
+			"/v1//books",
+			"/v1/{path=**}/books",
+			"/v1/{path=shelves/**}/books",
+			"/v1/{path=**/books}",
+			"/v1/**/books",
+			"/v1/books:",
+		} {
+			_, err := ParsePathTemplate(template)
+			a.NotNil(err, template)
+		}
+	})
+}
@@ -1,8 +1,11 @@
 package pocket
 
 import (
-	"regexp"
+	"errors"
+	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/juliangruber/go-intersect"
 	"google.golang.org/genproto/googleapis/api/annotations"
@@ -17,10 +20,19 @@ type FileExtensions struct {
 	OpenapiTitle   string
 	OpenapiVersion string
 	Servers        []*pocketpb.OpenapiServer
+	Responses      []*pocketpb.SharedResponse
+	Headers        []*pocketpb.ResponseHeader
+	License        *pocketpb.OpenapiLicense
+	Description    string
+	TermsOfService string
+	Contact        *pocketpb.OpenapiContact
+	ExternalDocs   *pocketpb.OpenapiExternalDocs
+	Tags           []*pocketpb.OpenapiTag
 }
 
 type ServiceExtensions struct {
 	Service *pocketpb.HttpService
+	Openapi *pocketpb.OpenapiService
 }
 
 type MethodExtensions struct {
@@ -56,6 +68,96 @@ func (e *MethodExtensions) HttpMethodAndEndpoint() (string, string) {
 	return getMethodAndEndpoint(e.GoogleApi)
 }
 
+// WebhookBinding gives the extensions of a webhook method, which is always a
+// POST request without an endpoint, sending the whole input message as its
+// body.
+func (e *MethodExtensions) WebhookBinding() *MethodExtensions {
+	return &MethodExtensions{
+		Method:        e.Method,
+		OpenapiMethod: e.OpenapiMethod,
+		EndpointDetails: &HttpEndpointDetails{
+			Method: http.MethodPost,
+			Body:   "*",
+		},
+	}
+}
+
+// HttpBindings gives all HTTP endpoints of a method, i.e., its main endpoint
+// followed by all its google.api.http additional bindings. Every binding
+// is represented by its own MethodExtensions, sharing every annotation but
+// the google.api.http one.
+func (e *MethodExtensions) HttpBindings() []*MethodExtensions {
+	bindings := []*MethodExtensions{e}
+
+	for _, rule := range e.GoogleApi.GetAdditionalBindings() {
+		bindings = append(bindings, &MethodExtensions{
+			GoogleApi:       rule,
+			Method:          e.Method,
+			OpenapiMethod:   e.OpenapiMethod,
+			EndpointDetails: getEndpointParameters(rule),
+		})
+	}
+
+	return bindings
+}
+
+// FieldLocation gives where a field must be placed inside a request for the
+// method endpoint. Fields that are part of the endpoint path are always
+// located there, while fields annotated to be located at the path, but
+// missing from it, are considered query parameters. Fields without a
+// location follow the endpoint body mapping, becoming query parameters when
+// they are not part of the body.
+func (e *MethodExtensions) FieldLocation(name string, fieldExtensions *FieldExtensions) pocketpb.HttpFieldLocation {
+	var (
+		location = fieldExtensions.PropertyLocation()
+		inPath   = false
+	)
+
+	if e.EndpointDetails != nil {
+		for _, p := range e.EndpointDetails.Parameters {
+			if p == name {
+				inPath = true
+				break
+			}
+		}
+	}
+
+	if inPath {
+		return pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_PATH
+	}
+	if location == pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_PATH {
+		return pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_QUERY
+	}
+	if location == pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_BODY && !e.IsBodyField(name) {
+		return pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_QUERY
+	}
+
+	return location
+}
+
+// HasBody tells if requests for the method endpoint have a body, i.e., if
+// its google.api.http body maps some of the input fields.
+func (e *MethodExtensions) HasBody() bool {
+	return e.EndpointDetails != nil && e.EndpointDetails.Body != ""
+}
+
+// IsBodyField tells if a field, not bound by the endpoint path, is sent
+// inside the request body. A "*" body maps all these fields, while a field
+// name maps only that field.
+func (e *MethodExtensions) IsBodyField(name string) bool {
+	if !e.HasBody() {
+		return false
+	}
+
+	return e.EndpointDetails.Body == "*" || e.EndpointDetails.Body == name
+}
+
+// PathTemplate parses the method endpoint path template.
+func (e *MethodExtensions) PathTemplate() (*PathTemplate, error) {
+	_, endpoint := e.HttpMethodAndEndpoint()
+	return ParsePathTemplate(endpoint)
+}
+
 func (e *MethodExtensions) HttpMethod() string {
 	if e.EndpointDetails != nil {
 		return strings.ToUpper(e.EndpointDetails.Method)
@@ -86,6 +188,47 @@ func (f *FieldExtensions) PropertyLocation() pocketpb.HttpFieldLocation {
 	return pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_BODY
 }
 
+// SharedResponses gives all shared responses of the service, by their names,
+// including the ones declared by its file.
+func (s *ServiceExtensions) SharedResponses(file *FileExtensions) map[string]*pocketpb.Response {
+	responses := make(map[string]*pocketpb.Response)
+
+	for _, r := range file.Responses {
+		responses[r.GetName()] = r.GetResponse()
+	}
+
+	for _, r := range s.Openapi.GetResponse() {
+		responses[r.GetName()] = r.GetResponse()
+	}
+
+	return responses
+}
+
+// SharedHeaders gives all shared response headers of the service, by their
+// names, including the ones declared by its file.
+func (s *ServiceExtensions) SharedHeaders(file *FileExtensions) map[string]*pocketpb.ResponseHeader {
+	headers := make(map[string]*pocketpb.ResponseHeader)
+
+	for _, h := range file.Headers {
+		headers[h.GetName()] = h
+	}
+
+	for _, h := range s.Openapi.GetHeader() {
+		headers[h.GetName()] = h
+	}
+
+	return headers
+}
+
+// SecuritySchemes gives all authentication schemes of the service.
+func (s *ServiceExtensions) SecuritySchemes() []*pocketpb.HttpSecurityScheme {
+	if s == nil {
+		return nil
+	}
+
+	return s.Service.GetSecurityScheme()
+}
+
 func (s *ServiceExtensions) GetHeaderMemberNames() map[string]string {
 	if s.Service == nil || len(s.Service.GetHeader()) == 0 {
 		return nil
@@ -105,9 +248,15 @@ func GetServiceExtensions(service *descriptor.ServiceDescriptorProto) *ServiceEx
 		s := proto.GetExtension(service.Options, pocketpb.E_ServiceDefinitions)
 
 		if svc, ok := s.(*pocketpb.HttpService); ok {
-			return &ServiceExtensions{
+			ext := &ServiceExtensions{
 				Service: svc,
 			}
+
+			if o, ok := proto.GetExtension(service.Options, pocketpb.E_Service).(*pocketpb.OpenapiService); ok {
+				ext.Openapi = o
+			}
+
+			return ext
 		}
 	}
 
@@ -158,11 +307,9 @@ func getEndpointParameters(googleApi *annotations.HttpRule) *HttpEndpointDetails
 
 	if googleApi != nil {
 		method, endpoint := getMethodAndEndpoint(googleApi)
-		parameters := retrieveEndpointParameters(endpoint)
-		parameters = append(parameters, retrieveEndpointParametersFromAdditionalBindings(googleApi)...)
 
 		details.Body = googleApi.GetBody()
-		details.Parameters = parameters
+		details.Parameters = retrieveEndpointParameters(endpoint)
 		details.Method = strings.ToUpper(method)
 	}
 
@@ -200,24 +347,19 @@ func getMethodAndEndpoint(rule *annotations.HttpRule) (string, string) {
 	return method, endpoint
 }
 
+// retrieveEndpointParameters gives the field paths of all variables of an
+// endpoint path template. Invalid templates give no parameters, since they
+// are reported when the template is used.
 func retrieveEndpointParameters(endpoint string) []string {
 	var parameters []string
-	re := regexp.MustCompile(`{[A-Za-z_.0-9]*}`)
 
-	for _, p := range re.FindAll([]byte(endpoint), -1) {
-		parameters = append(parameters, string(p[1:len(p)-1]))
+	template, err := ParsePathTemplate(endpoint)
+	if err != nil {
+		return nil
 	}
 
-	return parameters
-}
-
-func retrieveEndpointParametersFromAdditionalBindings(rule *annotations.HttpRule) []string {
-	var parameters []string
-
-	for _, r := range rule.GetAdditionalBindings() {
-		if _, endpoint := getMethodAndEndpoint(r); endpoint != "" {
-			parameters = append(parameters, retrieveEndpointParameters(endpoint)...)
-		}
+	for _, v := range template.Variables() {
+		parameters = append(parameters, v.FieldPath)
 	}
 
 	return parameters
@@ -263,10 +405,18 @@ func GetFieldExtensions(field *descriptor.FieldDescriptorProto) *FieldExtensions
 
 func GetFileExtensions(file *descriptor.FileDescriptorProto) *FileExtensions {
 	var (
-		name    string
-		title   string
-		version string
-		servers []*pocketpb.OpenapiServer
+		name        string
+		title       string
+		version     string
+		servers     []*pocketpb.OpenapiServer
+		responses   []*pocketpb.SharedResponse
+		headers     []*pocketpb.ResponseHeader
+		license     *pocketpb.OpenapiLicense
+		description string
+		terms       string
+		contact     *pocketpb.OpenapiContact
+		docs        *pocketpb.OpenapiExternalDocs
+		tags        []*pocketpb.OpenapiTag
 	)
 
 	if file.Options != nil {
@@ -285,6 +435,38 @@ func GetFileExtensions(file *descriptor.FileDescriptorProto) *FileExtensions {
 		if s := proto.GetExtension(file.Options, pocketpb.E_Server); s != nil {
 			servers = s.([]*pocketpb.OpenapiServer)
 		}
+
+		if r := proto.GetExtension(file.Options, pocketpb.E_Response); r != nil {
+			responses = r.([]*pocketpb.SharedResponse)
+		}
+
+		if h := proto.GetExtension(file.Options, pocketpb.E_Header); h != nil {
+			headers = h.([]*pocketpb.ResponseHeader)
+		}
+
+		if l, ok := proto.GetExtension(file.Options, pocketpb.E_License).(*pocketpb.OpenapiLicense); ok {
+			license = l
+		}
+
+		if d := proto.GetExtension(file.Options, pocketpb.E_Description); d != nil {
+			description = d.(string)
+		}
+
+		if t := proto.GetExtension(file.Options, pocketpb.E_TermsOfService); t != nil {
+			terms = t.(string)
+		}
+
+		if c, ok := proto.GetExtension(file.Options, pocketpb.E_Contact).(*pocketpb.OpenapiContact); ok {
+			contact = c
+		}
+
+		if d, ok := proto.GetExtension(file.Options, pocketpb.E_ExternalDocs).(*pocketpb.OpenapiExternalDocs); ok {
+			docs = d
+		}
+
+		if t := proto.GetExtension(file.Options, pocketpb.E_Tag); t != nil {
+			tags = t.([]*pocketpb.OpenapiTag)
+		}
 	}
 
 	return &FileExtensions{
@@ -292,7 +474,58 @@ func GetFileExtensions(file *descriptor.FileDescriptorProto) *FileExtensions {
 		OpenapiTitle:   title,
 		OpenapiVersion: version,
 		Servers:        servers,
+		Responses:      responses,
+		Headers:        headers,
+		License:        license,
+		Description:    description,
+		TermsOfService: terms,
+		Contact:        contact,
+		ExternalDocs:   docs,
+		Tags:           tags,
+	}
+}
+
+// SecuritySchemeName gives the name of a security scheme, which is
+// "authorization" when it does not set one.
+func SecuritySchemeName(scheme *pocketpb.HttpSecurityScheme) string {
+	if scheme.SchemeName != nil {
+		return scheme.GetSchemeName()
 	}
+
+	return "authorization"
+}
+
+// CheckSunset validates the sunset date of an element, which must be a date,
+// like "2025-12-31", and can only be used by deprecated elements.
+func CheckSunset(sunset string, deprecated bool) error {
+	if sunset == "" {
+		return nil
+	}
+
+	if !deprecated {
+		return fmt.Errorf("sunset date '%s' requires the deprecated option", sunset)
+	}
+
+	if _, err := time.Parse("2006-01-02", sunset); err != nil {
+		return fmt.Errorf("sunset date '%s' must be formatted as YYYY-MM-DD", sunset)
+	}
+
+	return nil
+}
+
+// ResponseStatus gives the HTTP status code of a response, which may also be a
+// range of codes, like "4XX", or "default". The response must set either its
+// code or its status.
+func ResponseStatus(response *pocketpb.Response) (string, error) {
+	if response.Status != nil {
+		return response.GetStatus(), nil
+	}
+
+	if response.Code == nil {
+		return "", errors.New("response must set either its code or its status")
+	}
+
+	return ResponseCodeToHttpCode(response.GetCode()), nil
 }
 
 func ResponseCodeToHttpCode(code pocketpb.ResponseCode) string {
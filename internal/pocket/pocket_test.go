@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	pocketpb "github.com/rsfreitas/protoc-gen-pocket-extensions/options/pocket"
 )
 
 func TestEnumStringsIntersection(t *testing.T) {
@@ -14,3 +17,49 @@ func TestEnumStringsIntersection(t *testing.T) {
 		a.Equal(i, "PROPERTY_FORMAT_")
 	})
 }
+
+func TestCheckSunset(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a := assert.New(t)
+		a.NoError(CheckSunset("", false))
+		a.NoError(CheckSunset("", true))
+		a.NoError(CheckSunset("2025-12-31", true))
+	})
+
+	t.Run("not deprecated", func(t *testing.T) {
+		assert.Error(t, CheckSunset("2025-12-31", false))
+	})
+
+	t.Run("invalid dates", func(t *testing.T) {
+		a := assert.New(t)
+		a.Error(CheckSunset("31/12/2025", true))
+		a.Error(CheckSunset("2025-02-30", true))
+		a.Error(CheckSunset("2025-12-31T00:00:00Z", true))
+	})
+}
+
+func TestResponseStatus(t *testing.T) {
+	t.Run("code", func(t *testing.T) {
+		status, err := ResponseStatus(&pocketpb.Response{Code: pocketpb.ResponseCode_RESPONSE_CODE_CREATED.Enum()})
+
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal("201", status)
+	})
+
+	t.Run("status", func(t *testing.T) {
+		status, err := ResponseStatus(&pocketpb.Response{
+			Code:   pocketpb.ResponseCode_RESPONSE_CODE_OK.Enum(),
+			Status: proto.String("4XX"),
+		})
+
+		a := assert.New(t)
+		a.NoError(err)
+		a.Equal("4XX", status)
+	})
+
+	t.Run("neither code nor status", func(t *testing.T) {
+		_, err := ResponseStatus(&pocketpb.Response{Description: proto.String("Success.")})
+		assert.ErrorContains(t, err, "response must set either its code or its status")
+	})
+}
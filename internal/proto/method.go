@@ -2,10 +2,12 @@ package proto
 
 import (
 	"fmt"
-	"net/http"
 	"strings"
 
+	"github.com/iancoleman/strcase"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
 
 	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/pocket"
 )
@@ -15,6 +17,21 @@ type Method struct {
 	Input      *MethodMessage
 	Output     *MethodMessage
 	extensions *pocket.MethodExtensions
+	service    *Service
+	template   *pocket.PathTemplate
+	deprecated bool
+
+	// query holds the parameters read from the query of the route.
+	query []*queryParameter
+
+	// rank is the rank of the method route when it captures the endpoint
+	// custom verb.
+	rank int
+
+	// bindingIndex tells which HTTP binding of the RPC this Method handles,
+	// where 0 is its main endpoint and the others are its additional
+	// bindings.
+	bindingIndex int
 }
 
 type MethodMessage struct {
@@ -32,6 +49,36 @@ func (m *Method) HasAuthentication() bool {
 	return false
 }
 
+// IsDeprecated tells if the method RPC is deprecated.
+func (m *Method) IsDeprecated() bool {
+	return m.deprecated
+}
+
+// DeprecatedAttribute gives the Rust attribute of the method handler when the
+// method is deprecated, with its sunset date, if any.
+func (m *Method) DeprecatedAttribute() string {
+	if sunset := m.extensions.OpenapiMethod.GetSunset(); sunset != "" {
+		return fmt.Sprintf("#[deprecated(note = \"sunset on %s\")]", sunset)
+	}
+
+	return "#[deprecated]"
+}
+
+// HandlerName gives the name of the function that handles the method HTTP
+// endpoint.
+func (m *Method) HandlerName() string {
+	name := strcase.ToSnake(m.Name)
+	if m.service != nil && m.service.prefixed {
+		name = strcase.ToSnake(m.service.Name) + "_" + name
+	}
+
+	if m.bindingIndex > 0 {
+		name += fmt.Sprintf("_%d", m.bindingIndex)
+	}
+
+	return name + "_handler"
+}
+
 // HasBody returns true or false if the current Method needs to parse the
 // request body or not.
 func (m *Method) HasBody() bool {
@@ -79,61 +126,590 @@ func (m *Method) QueryParameters() []*Parameter {
 }
 
 // RocketEndpoint converts the method endpoint to the rocket syntax.
+//
+// Only the last variable of the endpoint may capture many segments with a
+// '<name..>' segment, since rocket only accepts them at the end of the
+// route. Other variables become a segment for every literal or wildcard of
+// their patterns. Variables capturing fields of inner messages, like
+// '{book.id}', are captured by arguments named after their paths, like
+// 'book_id'.
 func (m *Method) RocketEndpoint() string {
-	_, endpoint := m.extensions.HttpMethodAndEndpoint()
-	re := strings.NewReplacer("{", "<", "}", ">")
-	endpoint = re.Replace(endpoint)
+	if m.template == nil {
+		_, endpoint := m.extensions.HttpMethodAndEndpoint()
+		return m.addQueryParameters(endpoint)
+	}
 
-	return m.addQueryParameters(endpoint)
+	route := m.rocketRoute()
+	return m.addQueryParameters("/" + strings.Join(route.segments, "/"))
 }
 
-func (m *Method) addQueryParameters(endpoint string) string {
-	hasQueryParameters := len(m.Input.Parameters) != len(m.extensions.EndpointDetails.Parameters)
-	if m.extensions.EndpointDetails.Body == "" && hasQueryParameters {
-		endpoint += "?"
-		var queryParameterNames []string
-		for _, p := range m.Input.Parameters {
-			if !isIn(m.extensions.EndpointDetails.Parameters, p.ProtoName) {
-				queryParameterNames = append(queryParameterNames, p.ProtoName)
+// Argument is a handler argument.
+type Argument struct {
+	Name     string
+	RustType string
+}
+
+// PathArguments gives the handler arguments that capture the endpoint path
+// segments.
+func (m *Method) PathArguments() []*Argument {
+	if m.template == nil {
+		var arguments []*Argument
+		for _, p := range m.PathParameters() {
+			arguments = append(arguments, &Argument{
+				Name:     p.ProtoName,
+				RustType: p.RustType(),
+			})
+		}
+
+		return arguments
+	}
+
+	return m.rocketRoute().arguments
+}
+
+// InputField is an input field initialized from the handler arguments.
+type InputField struct {
+	Name  string
+	Value string
+}
+
+// InputFields gives the input fields that are read from the endpoint path and
+// from query parameters, with the expressions that give their values from the
+// handler arguments, when the input is built by the handler. Fields of inner
+// messages are assigned afterwards, by InputAssignments.
+func (m *Method) InputFields() []*InputField {
+	if !m.NeedsInitializeInput() {
+		return nil
+	}
+
+	var fields []*InputField
+	for _, p := range m.PathParameters() {
+		fields = append(fields, &InputField{
+			Name:  p.ProtoName,
+			Value: m.pathValue(p.ProtoName),
+		})
+	}
+
+	for _, q := range m.query {
+		if !q.isMessage() {
+			fields = append(fields, &InputField{
+				Name:  q.ProtoName,
+				Value: m.queryPrefix() + q.ProtoName + q.BodyInitCall(),
+			})
+		}
+	}
+
+	return fields
+}
+
+// InputAssignment sets an input field, which may be a field of an inner
+// message, like 'book.get_or_insert_with(Default::default).id', from the
+// handler arguments.
+type InputAssignment struct {
+	Target string
+	Value  string
+}
+
+// InputAssignments gives the input fields that are set after the input is
+// built: the fields of inner messages read from the endpoint path or from
+// query parameters and, for a "*" body, the fields bound by the path or
+// located at the query, which take precedence over the body.
+func (m *Method) InputAssignments() []*InputAssignment {
+	var assignments []*InputAssignment
+
+	if !m.NeedsInitializeInput() {
+		for _, p := range m.PathParameters() {
+			assignments = append(assignments, &InputAssignment{
+				Target: p.ProtoName,
+				Value:  m.pathValue(p.ProtoName),
+			})
+		}
+
+		for _, q := range m.query {
+			if !q.isMessage() {
+				assignments = append(assignments, &InputAssignment{
+					Target: q.ProtoName,
+					Value:  m.queryPrefix() + q.ProtoName,
+				})
 			}
 		}
+	}
 
-		for i, name := range queryParameterNames {
-			if i > 0 {
-				endpoint += "&"
+	if m.template != nil {
+		for _, v := range m.template.Variables() {
+			if strings.Contains(v.FieldPath, ".") && m.searchInputFieldByPath(v.FieldPath) != nil {
+				assignments = append(assignments, &InputAssignment{
+					Target: fieldTarget(v.FieldPath),
+					Value:  m.pathValue(v.FieldPath),
+				})
 			}
+		}
+	}
+
+	return append(assignments, m.queryAssignments(m.query)...)
+}
 
-			endpoint += fmt.Sprintf("<%v>", name)
+// queryAssignments gives the assignments of the fields of the message query
+// parameters.
+func (m *Method) queryAssignments(parameters []*queryParameter) []*InputAssignment {
+	var assignments []*InputAssignment
+
+	for _, q := range parameters {
+		if !q.isMessage() {
+			continue
+		}
+
+		for _, f := range q.fields {
+			if !f.isMessage() {
+				assignments = append(assignments, &InputAssignment{
+					Target: fieldTarget(f.path),
+					Value:  m.queryPrefix() + f.path,
+				})
+			}
 		}
+
+		assignments = append(assignments, m.queryAssignments(q.fields)...)
 	}
 
-	return endpoint
+	return assignments
 }
 
-// BodyArgumentType gives the variable type of the body.
-func (m *Method) BodyArgumentType() string {
-	// If we're dealing with a POST method, probably the entire RPC input message
-	// will be used as the body.
-	if m.extensions.EndpointDetails.Method == http.MethodPost {
-		return m.Input.Name
+// fieldTarget gives the rust expression of a field path, where inner
+// messages, which are optional, are created when missing.
+func fieldTarget(fieldPath string) string {
+	names := strings.Split(fieldPath, ".")
+	for i := range names[:len(names)-1] {
+		names[i] += ".get_or_insert_with(Default::default)"
+	}
+
+	return strings.Join(names, ".")
+}
+
+// pathValue gives the expression that gives the value of a field captured by
+// the endpoint path from the handler arguments. Values that cannot be
+// converted to their field types are rejected with a 400 response.
+func (m *Method) pathValue(fieldPath string) string {
+	var (
+		p    = m.searchInputFieldByPath(fieldPath)
+		name = pathArgumentName(fieldPath)
+	)
+
+	if m.template != nil {
+		if captured, ok := m.rocketRoute().values[fieldPath]; ok {
+			value := captured.expression
+			if !captured.owned || p.spec.Desc.Kind() != protoreflect.StringKind {
+				value += p.fromStringCall()
+			}
+
+			return value
+		}
+	}
+
+	return name + p.BodyInitCall()
+}
+
+// pathArgumentName gives the name of the handler argument capturing a path
+// variable.
+func pathArgumentName(fieldPath string) string {
+	return strings.ReplaceAll(fieldPath, ".", "_")
+}
+
+// VerbSegment is a rocket guard for the last segments of a route, which only
+// matches them when they end with the endpoint custom verb. Since rocket
+// parameters must use whole segments, it is used when the verb follows a
+// variable or a wildcard.
+type VerbSegment struct {
+	TypeName     string
+	Verb         string
+	MultiSegment bool
+}
+
+// VerbSegment gives the guard of the route last segments when they capture
+// the endpoint custom verb, if any.
+func (m *Method) VerbSegment() *VerbSegment {
+	if !m.capturesVerb() {
+		return nil
+	}
+
+	return &VerbSegment{
+		TypeName:     strcase.ToCamel(strings.TrimSuffix(m.HandlerName(), "_handler")) + "Segment",
+		Verb:         m.template.Verb,
+		MultiSegment: m.template.Segments[len(m.template.Segments)-1].IsMultiSegment(),
+	}
+}
+
+// Rank gives the rank of the route when it captures the custom verb.
+func (m *Method) Rank() int {
+	return m.rank
+}
+
+// capturesVerb tells if the endpoint custom verb follows a variable or a
+// wildcard, being captured with them.
+func (m *Method) capturesVerb() bool {
+	if m.template == nil || m.template.Verb == "" {
+		return false
+	}
+
+	last := m.template.Segments[len(m.template.Segments)-1]
+	return last.Variable != nil || last.Literal == "*" || last.Literal == "**"
+}
+
+// rocketVerbRank is the rank of the first route capturing a custom verb.
+// Default rocket ranks go from -12 to -1, so these routes, which forward
+// requests without their verbs, are tried before the others.
+const rocketVerbRank = -13
+
+// rocketRoute is the endpoint path in the rocket syntax.
+type rocketRoute struct {
+	segments  []string
+	arguments []*Argument
+
+	// values holds the strings captured by the variables that are not
+	// handler arguments with their field types, by their field paths.
+	values map[string]*capturedValue
+}
+
+// capturedValue is an expression giving the string captured by a variable.
+type capturedValue struct {
+	expression string
+
+	// owned tells if the expression already gives a String.
+	owned bool
+}
+
+func (m *Method) rocketRoute() *rocketRoute {
+	var (
+		route = &rocketRoute{
+			values: make(map[string]*capturedValue),
+		}
+		verb = m.VerbSegment()
+	)
+
+	for i, segment := range m.template.Segments {
+		last := i == len(m.template.Segments)-1
+
+		if segment.Variable == nil {
+			switch {
+			case last && verb != nil:
+				route.addVerbSegment("_segment", verb)
+			case segment.Literal == "*":
+				route.segments = append(route.segments, "<_>")
+			case segment.Literal == "**":
+				route.segments = append(route.segments, "<_..>")
+			default:
+				literal := segment.Literal
+				if last && m.template.Verb != "" {
+					literal += ":" + m.template.Verb
+				}
+
+				route.segments = append(route.segments, literal)
+			}
+
+			continue
+		}
+
+		var (
+			v    = segment.Variable
+			name = pathArgumentName(v.FieldPath)
+		)
+
+		switch {
+		case last && verb != nil:
+			route.addVerbSegment(name, verb)
+			route.values[v.FieldPath] = &capturedValue{expression: name + ".0", owned: true}
+
+		case last && v.IsMultiSegment():
+			route.segments = append(route.segments, "<"+name+"..>")
+			route.arguments = append(route.arguments, &Argument{Name: name, RustType: "std::path::PathBuf"})
+			route.values[v.FieldPath] = &capturedValue{expression: name + ".to_string_lossy()"}
+
+		case v.HasPattern():
+			route.addPatternSegments(name, v)
+
+		default:
+			route.segments = append(route.segments, "<"+name+">")
+			route.arguments = append(route.arguments, &Argument{Name: name, RustType: m.pathParameterType(v.FieldPath)})
+		}
+	}
+
+	return route
+}
+
+// addVerbSegment captures the last segments of the route, with the custom
+// verb, into a guard argument.
+func (r *rocketRoute) addVerbSegment(name string, verb *VerbSegment) {
+	segment := "<" + name + ">"
+	if verb.MultiSegment {
+		segment = "<" + name + "..>"
+	}
+
+	r.segments = append(r.segments, segment)
+	r.arguments = append(r.arguments, &Argument{Name: name, RustType: verb.TypeName})
+}
+
+// addPatternSegments adds a segment for every literal or wildcard of the
+// variable pattern, capturing every wildcard into an argument of its own.
+func (r *rocketRoute) addPatternSegments(name string, v *pocket.PathVariable) {
+	var (
+		wildcards int
+		format    []string
+		arguments []string
+	)
+
+	for _, s := range v.Segments {
+		if s == "*" {
+			wildcards++
+		}
+	}
+
+	for _, s := range v.Segments {
+		if s != "*" {
+			r.segments = append(r.segments, s)
+			format = append(format, s)
+			continue
+		}
+
+		argument := name
+		if wildcards > 1 {
+			argument = fmt.Sprintf("%s_%d", name, len(arguments)+1)
+		}
+
+		r.segments = append(r.segments, "<"+argument+">")
+		r.arguments = append(r.arguments, &Argument{Name: argument, RustType: "String"})
+		format = append(format, "{}")
+		arguments = append(arguments, argument)
+	}
+
+	value := &capturedValue{expression: fmt.Sprintf(`"%s"`, strings.Join(format, "/"))}
+	if len(arguments) > 0 {
+		value.expression = fmt.Sprintf(`format!(%s, %s)`, value.expression, strings.Join(arguments, ", "))
+		value.owned = true
 	}
 
-	if p := m.searchInputParameterByProtoName(m.extensions.EndpointDetails.Body); p != nil {
+	r.values[v.FieldPath] = value
+}
+
+// pathParameterType gives the rust type of the input field captured by a
+// path variable.
+func (m *Method) pathParameterType(fieldPath string) string {
+	if p := m.searchInputFieldByPath(fieldPath); p != nil {
 		return p.RustType()
 	}
 
-	if len(m.extensions.EndpointDetails.Parameters) > 0 {
-		return m.Input.Name
+	return "String"
+}
+
+// QueryForm is a rocket form reading query parameters. Routes read their
+// query parameters through a form when some of them are not named after their
+// fields, since route parameters must be named after their handler arguments,
+// and messages read the query parameters of their fields through forms of
+// their own.
+type QueryForm struct {
+	TypeName string
+	Fields   []*QueryFormField
+}
+
+// QueryFormField is a field of a QueryForm, read from the Key query
+// parameter.
+type QueryFormField struct {
+	Name     string
+	Key      string
+	RustType string
+}
+
+// IsRenamed tells if the field is not named after its query parameter.
+func (f *QueryFormField) IsRenamed() bool {
+	return f.Key != f.Name
+}
+
+// QueryForms gives the forms that read the query parameters of the route, if
+// they need any.
+func (m *Method) QueryForms() []*QueryForm {
+	forms := m.messageQueryForms(m.query)
+	if m.hasRenamedQueryParameters() {
+		forms = append(forms, m.queryForm("", m.query))
+	}
+
+	return forms
+}
+
+// messageQueryForms gives the forms of the message query parameters, with
+// the forms of their inner messages.
+func (m *Method) messageQueryForms(parameters []*queryParameter) []*QueryForm {
+	var forms []*QueryForm
+
+	for _, q := range parameters {
+		if q.isMessage() {
+			forms = append(forms, m.messageQueryForms(q.fields)...)
+			forms = append(forms, m.queryForm(q.path, q.fields))
+		}
+	}
+
+	return forms
+}
+
+func (m *Method) queryForm(path string, parameters []*queryParameter) *QueryForm {
+	form := &QueryForm{
+		TypeName: m.queryTypeName(path),
+	}
+
+	for _, q := range parameters {
+		form.Fields = append(form.Fields, &QueryFormField{
+			Name:     q.ProtoName,
+			Key:      q.JsonName,
+			RustType: m.queryRustType(q),
+		})
+	}
+
+	return form
+}
+
+// queryTypeName gives the name of the form reading the query parameters of
+// a message, by its field path, or of the route, with an empty path.
+func (m *Method) queryTypeName(path string) string {
+	return strcase.ToCamel(strings.TrimSuffix(m.HandlerName(), "_handler")) +
+		strcase.ToCamel(pathArgumentName(path)) + "Query"
+}
+
+// queryRustType gives the rust type that reads a query parameter.
+func (m *Method) queryRustType(q *queryParameter) string {
+	if q.isMessage() {
+		return m.queryTypeName(q.path)
+	}
+
+	if q.spec.Desc.IsList() {
+		return fmt.Sprintf("Vec<%s>", q.RustType())
+	}
+
+	return q.RustType()
+}
+
+// QueryArguments gives the handler arguments that read query parameters.
+func (m *Method) QueryArguments() []*Argument {
+	if m.hasRenamedQueryParameters() {
+		return []*Argument{{Name: "query", RustType: m.queryTypeName("")}}
+	}
+
+	var arguments []*Argument
+	for _, q := range m.query {
+		arguments = append(arguments, &Argument{
+			Name:     q.ProtoName,
+			RustType: m.queryRustType(q),
+		})
+	}
+
+	return arguments
+}
+
+// queryPrefix gives the prefix of the expressions that read query
+// parameters from the handler arguments.
+func (m *Method) queryPrefix() string {
+	if m.hasRenamedQueryParameters() {
+		return "query."
 	}
 
 	return ""
 }
 
+// hasRenamedQueryParameters returns true if some query parameter is not named
+// after its proto name.
+func (m *Method) hasRenamedQueryParameters() bool {
+	for _, q := range m.query {
+		if q.JsonName != q.ProtoName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Method) addQueryParameters(endpoint string) string {
+	if len(m.query) == 0 {
+		return endpoint
+	}
+
+	if m.hasRenamedQueryParameters() {
+		return endpoint + "?<query..>"
+	}
+
+	endpoint += "?"
+	for i, q := range m.query {
+		if i > 0 {
+			endpoint += "&"
+		}
+
+		endpoint += fmt.Sprintf("<%v>", q.ProtoName)
+	}
+
+	return endpoint
+}
+
+// BodyArgumentType gives the variable type of the body, which is the whole
+// input message for a "*" body or the type of the field mapped by the body.
+// Messages and enums are declared inside the module of the protobuf package.
+func (m *Method) BodyArgumentType(module string) string {
+	if m.extensions.EndpointDetails.Body == "*" {
+		return fmt.Sprintf("crate::%s::%s", module, m.Input.Name)
+	}
+
+	p := m.searchInputParameterByProtoName(m.extensions.EndpointDetails.Body)
+	if p == nil {
+		return ""
+	}
+
+	rustType := p.RustType()
+	if kind := p.spec.Desc.Kind(); kind == protoreflect.MessageKind || kind == protoreflect.EnumKind {
+		rustType = fmt.Sprintf("crate::%s::%s", module, rustType)
+	}
+	if p.spec.Desc.IsList() {
+		rustType = fmt.Sprintf("Vec<%s>", rustType)
+	}
+
+	return rustType
+}
+
 // BodyArgumentName gives the variable name of the body.
 func (m *Method) BodyArgumentName() string {
 	return m.extensions.EndpointDetails.Body
 }
 
+// BodyArgumentValue gives the value of the input field mapped by the body,
+// where singular messages are optional.
+func (m *Method) BodyArgumentValue() string {
+	p := m.searchInputParameterByProtoName(m.extensions.EndpointDetails.Body)
+	if p != nil && p.spec.Desc.Kind() == protoreflect.MessageKind && !p.spec.Desc.IsList() && !p.spec.Desc.IsMap() {
+		return "Some(req.into_inner())"
+	}
+
+	return "req.into_inner()"
+}
+
+// searchInputFieldByPath gives the input field of a field path, which may be
+// a field of an inner message, like 'book.id'.
+func (m *Method) searchInputFieldByPath(fieldPath string) *Parameter {
+	var (
+		names = strings.Split(fieldPath, ".")
+		p     = m.searchInputParameterByProtoName(names[0])
+	)
+
+	for _, name := range names[1:] {
+		if p == nil || p.spec.Message == nil || p.spec.Desc.IsList() || p.spec.Desc.IsMap() {
+			return nil
+		}
+
+		var field *Parameter
+		for _, f := range p.spec.Message.Fields {
+			if string(f.Desc.Name()) == name {
+				field = &Parameter{spec: f, GoName: f.GoName, ProtoName: name}
+			}
+		}
+
+		p = field
+	}
+
+	return p
+}
+
 func (m *Method) searchInputParameterByProtoName(protoName string) *Parameter {
 	for _, p := range m.Input.Parameters {
 		if p.ProtoName == protoName {
@@ -159,34 +735,76 @@ func (m *Method) IsHttp() bool {
 	return m.extensions.GoogleApi != nil
 }
 
-func parseMethods(file *protogen.File) ([]*Method, error) {
-	// Probably the first service is what we want.
-	service := file.Proto.Service[0]
-
+func parseMethods(file *protogen.File, service *descriptor.ServiceDescriptorProto, naming pocket.FieldNaming) ([]*Method, error) {
 	var methods []*Method
+
 	for _, method := range service.Method {
-		extensions := pocket.GetMethodExtensions(method)
+		var (
+			extensions = pocket.GetMethodExtensions(method)
+			deprecated = method.GetOptions().GetDeprecated()
+		)
 
-		inputParameters, err := parseParametersFromMessage(file, method.GetInputType(), extensions)
+		if err := pocket.CheckSunset(extensions.OpenapiMethod.GetSunset(), deprecated); err != nil {
+			return nil, fmt.Errorf("method '%s': %w", method.GetName(), err)
+		}
+
+		input, err := searchPackageMessageByName(file, method.GetInputType())
 		if err != nil {
 			return nil, err
 		}
 
-		methods = append(methods, &Method{
-			extensions: extensions,
-			Name:       method.GetName(),
-			Input: &MethodMessage{
-				Name:       filterPackageName(method.GetInputType()),
-				Parameters: inputParameters,
-			},
-			Output: &MethodMessage{
-				Name: filterPackageName(method.GetOutputType()),
-			},
-		})
+		outputName := filterPackageName(method.GetOutputType())
+		if output, err := searchPackageMessageByName(file, method.GetOutputType()); err == nil {
+			outputName = rustTypePath(output.Desc)
+		}
+
+		// Every additional binding needs its own handler, since it may have
+		// different parameters.
+		for index, binding := range extensions.HttpBindings() {
+			var template *pocket.PathTemplate
+			if binding.GoogleApi != nil {
+				t, err := binding.PathTemplate()
+				if err != nil {
+					return nil, fmt.Errorf("method '%s': %w", method.GetName(), err)
+				}
+				template = t
+			}
+
+			inputParameters, err := parseParametersFromMessage(input, binding, naming)
+			if err != nil {
+				return nil, err
+			}
+
+			methods = append(methods, &Method{
+				extensions:   binding,
+				template:     template,
+				deprecated:   deprecated,
+				query:        parseQueryParameters(inputParameters, binding, naming),
+				bindingIndex: index,
+				Name:         method.GetName(),
+				Input: &MethodMessage{
+					Name:       rustTypePath(input.Desc),
+					Parameters: inputParameters,
+				},
+				Output: &MethodMessage{
+					Name: outputName,
+				},
+			})
+		}
 
 		// TODO: validate method?
 	}
 
+	// Routes capturing custom verbs need ranks of their own, since they use
+	// the same segments as the routes without verbs, or with other verbs.
+	rank := rocketVerbRank
+	for _, m := range methods {
+		if m.capturesVerb() {
+			m.rank = rank
+			rank--
+		}
+	}
+
 	return methods, nil
 }
 
@@ -1,12 +1,14 @@
 package proto
 
 import (
-	"net/http"
+	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protodesc"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/pocket"
+	pocketpb "github.com/rsfreitas/protoc-gen-pocket-extensions/options/pocket"
 )
 
 type Parameter struct {
@@ -14,6 +16,10 @@ type Parameter struct {
 	ProtoName string
 	Location  ParameterLocation
 
+	// JsonName is the parameter name when it is a query parameter, which
+	// follows the same naming used by the message JSON form.
+	JsonName string
+
 	spec *protogen.Field
 }
 
@@ -45,6 +51,88 @@ func (p ParameterLocation) String() string {
 	return "unknown"
 }
 
+// queryParameter is a query parameter read by a handler. Singular message
+// fields are not read themselves, but from the query parameters of their
+// fields, named after their paths, like 'book.title'.
+type queryParameter struct {
+	*Parameter
+
+	// path is the proto field path of the parameter.
+	path   string
+	fields []*queryParameter
+}
+
+func (q *queryParameter) isMessage() bool {
+	return len(q.fields) > 0
+}
+
+// parseQueryParameters gives the query parameters of a method, leaving out
+// the fields of its messages bound by the endpoint path, the same way its
+// OpenAPI operation does.
+func parseQueryParameters(parameters []*Parameter, extensions *pocket.MethodExtensions, naming pocket.FieldNaming) []*queryParameter {
+	var (
+		query   []*queryParameter
+		visited = make(map[protoreflect.FullName]bool)
+	)
+
+	for _, p := range parameters {
+		if p.Location != ParameterLocation_Query {
+			continue
+		}
+
+		if q := newQueryParameter(p, p.ProtoName, extensions.EndpointDetails.Parameters, naming, visited); q != nil {
+			query = append(query, q)
+		}
+	}
+
+	return query
+}
+
+// newQueryParameter gives the query parameter of a field, if it can be one.
+// Repeated messages, and messages without fields to read, cannot.
+func newQueryParameter(p *Parameter, path string, bound []string, naming pocket.FieldNaming, visited map[protoreflect.FullName]bool) *queryParameter {
+	var (
+		desc = p.spec.Desc
+		q    = &queryParameter{Parameter: p, path: path}
+	)
+
+	if desc.Kind() != protoreflect.MessageKind || desc.IsMap() || isWellKnownType(desc.Message()) {
+		return q
+	}
+
+	name := desc.Message().FullName()
+	if desc.IsList() || visited[name] {
+		return nil
+	}
+
+	visited[name] = true
+	defer delete(visited, name)
+
+	for _, field := range p.spec.Message.Fields {
+		fieldPath := path + "." + string(field.Desc.Name())
+		if isIn(bound, fieldPath) {
+			continue
+		}
+
+		inner := newQueryParameter(newParameter(field, naming, ParameterLocation_Query), fieldPath, bound, naming, visited)
+		if inner != nil {
+			q.fields = append(q.fields, inner)
+		}
+	}
+
+	if len(q.fields) == 0 {
+		return nil
+	}
+
+	return q
+}
+
+// isWellKnownType tells if a message is one of the protobuf well-known types,
+// which are not read from the query parameters of their fields.
+func isWellKnownType(msg protoreflect.MessageDescriptor) bool {
+	return strings.HasPrefix(string(msg.FullName()), "google.protobuf.")
+}
+
 // RustType gives the rust type of the parameter
 func (p *Parameter) RustType() string {
 	rt := ""
@@ -75,12 +163,10 @@ func (p *Parameter) RustType() string {
 		rt = "f64"
 
 	case protoreflect.EnumKind:
-		desc := p.spec.Desc.Enum().(protoreflect.Descriptor)
-		rt = string(desc.Name())
+		rt = rustTypePath(p.spec.Desc.Enum())
 
 	case protoreflect.MessageKind:
-		desc := p.spec.Desc.Message().(protoreflect.Descriptor)
-		rt = string(desc.Name())
+		rt = rustTypePath(p.spec.Desc.Message())
 
 	case protoreflect.BytesKind:
 		// TODO: ???
@@ -121,24 +207,22 @@ func (p *Parameter) BodyInitCall() string {
 	return call
 }
 
-func parseParametersFromMessage(file *protogen.File, messageName string, extensions *pocket.MethodExtensions) ([]*Parameter, error) {
-	//msg, msgDescriptor, err := searchPackageMessageByName(file, messageName)
-	msg, _, err := searchPackageMessageByName(file, messageName)
-	if err != nil {
-		return nil, err
+// fromStringCall gives the call that converts a string slice, captured from
+// the endpoint path, into the parameter type, making the handler answer with
+// a 400 response when it fails.
+func (p *Parameter) fromStringCall() string {
+	if p.spec.Desc.Kind() == protoreflect.StringKind {
+		return ".to_string()"
 	}
 
+	return ".parse().map_err(|_| rocket::http::Status::BadRequest)?"
+}
+
+func parseParametersFromMessage(msg *protogen.Message, extensions *pocket.MethodExtensions, naming pocket.FieldNaming) ([]*Parameter, error) {
 	var parameters []*Parameter
 
 	for _, field := range msg.Fields {
-		desc := field.Desc.(protoreflect.Descriptor)
-		protoName := string(desc.Name())
-		parameters = append(parameters, &Parameter{
-			spec:      field,
-			GoName:    field.GoName,
-			ProtoName: protoName,
-			Location:  getFieldLocation(protoName, extensions),
-		})
+		parameters = append(parameters, newParameter(field, naming, getFieldLocation(field, extensions)))
 
 		// TODO: validate Parameter?
 	}
@@ -146,26 +230,34 @@ func parseParametersFromMessage(file *protogen.File, messageName string, extensi
 	return parameters, nil
 }
 
-func getFieldLocation(name string, extensions *pocket.MethodExtensions) ParameterLocation {
+func newParameter(field *protogen.Field, naming pocket.FieldNaming, location ParameterLocation) *Parameter {
+	return &Parameter{
+		spec:      field,
+		GoName:    field.GoName,
+		ProtoName: string(field.Desc.Name()),
+		JsonName:  naming.FieldName(protodesc.ToFieldDescriptorProto(field.Desc)),
+		Location:  location,
+	}
+}
+
+// getFieldLocation gives where a field is read from a request, which is the
+// same location its OpenAPI operation documents.
+func getFieldLocation(field *protogen.Field, extensions *pocket.MethodExtensions) ParameterLocation {
 	var (
-		location = ParameterLocation_Body
-		found    = isIn(extensions.EndpointDetails.Parameters, name)
+		fieldExtensions = pocket.GetFieldExtensions(protodesc.ToFieldDescriptorProto(field.Desc))
+		location        = extensions.FieldLocation(string(field.Desc.Name()), fieldExtensions)
 	)
 
-	if found {
-		location = ParameterLocation_Path
-	}
-	if !found && extensions.EndpointDetails.Method == http.MethodGet {
-		location = ParameterLocation_Query
-	}
-	if !found && extensions.HasKrillHttpExtension() {
-		for _, p := range extensions.Method.GetHeader() {
-			if name == p.GetName() {
-				location = ParameterLocation_Header
-				break
-			}
-		}
+	switch location {
+	case pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_HEADER:
+		return ParameterLocation_Header
+
+	case pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_PATH:
+		return ParameterLocation_Path
+
+	case pocketpb.HttpFieldLocation_HTTP_FIELD_LOCATION_QUERY:
+		return ParameterLocation_Query
 	}
 
-	return location
+	return ParameterLocation_Body
 }
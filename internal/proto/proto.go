@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/iancoleman/strcase"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	descriptor "google.golang.org/protobuf/types/descriptorpb"
 
 	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/pocket"
@@ -14,78 +16,149 @@ import (
 type Spec struct {
 	AppName     string
 	PackageName string
-	ServiceName string
-	Methods     []*Method
+	Services    []*Service
 }
 
-type FieldAttribute struct {
-	Name      string
-	Attribute string
+// Service is a service declared by the protobuf file.
+type Service struct {
+	Name    string
+	Methods []*Method
+
+	// prefixed tells if the names of the service functions, like its
+	// handlers, are prefixed by the service name, which happens when the
+	// file has more than a single HTTP service.
+	prefixed bool
 }
 
-func GetPackageName(plugin *protogen.Plugin) (string, error) {
-	file, err := GetProtoFile(plugin)
-	if err != nil {
-		return "", err
+// IsHttp tells if the service has at least one endpoint declaration.
+func (s *Service) IsHttp() bool {
+	for _, m := range s.Methods {
+		if m.IsHttp() {
+			return true
+		}
 	}
-	if file == nil {
-		return "", nil
+
+	return false
+}
+
+// HasDeprecatedMethods tells if some HTTP method of the service is
+// deprecated, making its handler deprecated as well.
+func (s *Service) HasDeprecatedMethods() bool {
+	for _, m := range s.Methods {
+		if m.IsHttp() && m.IsDeprecated() {
+			return true
+		}
 	}
 
-	return string(file.GoPackageName), nil
+	return false
 }
 
-func GetProtoFilePath(plugin *protogen.Plugin) (string, error) {
-	file, err := GetProtoFile(plugin)
-	if err != nil {
-		return "", err
+// RouterName gives the name of the function that builds the service HTTP
+// router.
+func (s *Service) RouterName() string {
+	if s.prefixed {
+		return strcase.ToSnake(s.Name) + "_http_router"
 	}
 
-	return file.Proto.GetName(), nil
+	return "http_router"
 }
 
-func GetFieldAttributes(plugin *protogen.Plugin) []*FieldAttribute {
-	var fields []*FieldAttribute
+type FieldAttribute struct {
+	Name      string
+	Attribute string
+}
 
-	for _, file := range plugin.FilesByPath {
-		if !file.Generate {
-			// Only deals with file that is going to be processed.
-			continue
-		}
+// GetFieldAttributes gives the attributes of all fields of the messages
+// declared by a file.
+func GetFieldAttributes(file *protogen.File, naming pocket.FieldNaming) []*FieldAttribute {
+	var fields []*FieldAttribute
 
-		for _, msg := range file.Proto.MessageType {
-			fields = append(fields, getFieldAttributesFromMessage(file.Proto.GetPackage(), msg)...)
-		}
+	for _, msg := range file.Proto.MessageType {
+		fields = append(fields, getFieldAttributesFromMessage(file.Proto.GetPackage(), msg, naming)...)
 	}
 
 	return fields
 }
 
-func getFieldAttributesFromMessage(packageName string, message *descriptor.DescriptorProto) []*FieldAttribute {
-	var fields []*FieldAttribute
+// getFieldAttributesFromMessage gives the attributes of all fields of a
+// message, including the ones of its nested messages. The parentName must be
+// the message fully-qualified parent name, i.e., its package name for top
+// level messages.
+//
+// Fields whose JSON names differ from their proto names are renamed, so that
+// serde uses the same names documented by the OpenAPI spec.
+func getFieldAttributesFromMessage(parentName string, message *descriptor.DescriptorProto, naming pocket.FieldNaming) []*FieldAttribute {
+	var (
+		fields      []*FieldAttribute
+		messageName = fmt.Sprintf("%v.%v", parentName, message.GetName())
+	)
 
 	for _, field := range message.Field {
 		extensions := pocket.GetFieldExtensions(field)
 		if extensions.Database != nil {
 			fields = append(fields, &FieldAttribute{
-				Name:      fmt.Sprintf(".%v.%v.%v", packageName, message.GetName(), field.GetName()),
+				Name:      fmt.Sprintf(".%v.%v", messageName, field.GetName()),
 				Attribute: fmt.Sprintf(`#[serde(rename(serialize = \"%v\", deserialize = \"%v\"))]`, extensions.Database.GetName(), extensions.Database.GetName()),
 			})
+			continue
+		}
+
+		if name := naming.FieldName(field); name != field.GetName() {
+			fields = append(fields, &FieldAttribute{
+				Name:      fmt.Sprintf(".%v.%v", fieldAttributePath(messageName, message, field), field.GetName()),
+				Attribute: fmt.Sprintf(`#[serde(rename = \"%v\")]`, name),
+			})
 		}
 	}
 
+	for _, nested := range message.NestedType {
+		fields = append(fields, getFieldAttributesFromMessage(messageName, nested, naming)...)
+	}
+
 	return fields
 }
 
-func Parse(plugin *protogen.Plugin) (*Spec, error) {
-	file, err := GetProtoFile(plugin)
-	if err != nil {
-		return nil, err
+// fieldAttributePath gives the path used by prost to find the parent of a
+// field. Members of a oneof are variants of an enum named after the oneof.
+func fieldAttributePath(messageName string, message *descriptor.DescriptorProto, field *descriptor.FieldDescriptorProto) string {
+	if field.OneofIndex == nil || field.GetProto3Optional() {
+		return messageName
+	}
+
+	return fmt.Sprintf("%v.%v", messageName, message.OneofDecl[field.GetOneofIndex()].GetName())
+}
+
+// Parse parses the services of a file.
+func Parse(file *protogen.File, naming pocket.FieldNaming) (*Spec, error) {
+	var (
+		services     []*Service
+		httpServices int
+	)
+
+	for _, service := range file.Proto.Service {
+		methods, err := parseMethods(file, service, naming)
+		if err != nil {
+			return nil, err
+		}
+
+		s := &Service{
+			Name:    service.GetName(),
+			Methods: methods,
+		}
+		if s.IsHttp() {
+			httpServices++
+		}
+
+		services = append(services, s)
 	}
 
-	methods, err := parseMethods(file)
-	if err != nil {
-		return nil, err
+	// HTTP services of the same file share the http.rs module, so their
+	// functions are prefixed by their names when there are many of them.
+	for _, s := range services {
+		s.prefixed = httpServices > 1
+		for _, m := range s.Methods {
+			m.service = s
+		}
 	}
 
 	extensions := pocket.GetFileExtensions(file.Proto)
@@ -95,71 +168,61 @@ func Parse(plugin *protogen.Plugin) (*Spec, error) {
 
 	return &Spec{
 		AppName:     extensions.AppName,
-		Methods:     methods,
-		ServiceName: file.Proto.Service[0].GetName(),
+		Services:    services,
 		PackageName: file.Proto.GetPackage(),
 	}, nil
 }
 
-func GetProtoFile(plugin *protogen.Plugin) (*protogen.File, error) {
-	if len(plugin.Files) == 0 {
-		return nil, errors.New("cannot find the module name without .proto files")
-	}
+// searchPackageMessageByName searches for a protobuf message, even a nested
+// one, by its fully-qualified name inside the file package.
+func searchPackageMessageByName(file *protogen.File, fullyQualifiedName string) (*protogen.Message, error) {
+	var (
+		name        = strings.TrimPrefix(fullyQualifiedName, ".")
+		packageName = string(file.Desc.Package())
+	)
 
-	// The last file in the slice is always the main .proto file that is being
-	// "compiled" by protoc.
-	file := plugin.Files[len(plugin.Files)-1]
-	if !file.Generate {
-		return nil, errors.New("proto file not meant to be generated")
+	// Assures that we only search for messages that belongs to the same
+	// package.
+	if !strings.HasPrefix(name, packageName+".") {
+		return nil, fmt.Errorf("message '%s' does not belong to the current package", fullyQualifiedName)
 	}
 
-	// Proto file has no service declared. Nothing for us to handle here.
-	if len(file.Services) == 0 {
-		return nil, nil
+	if msg := searchMessageByFullName(file.Messages, name); msg != nil {
+		return msg, nil
 	}
 
-	return file, nil
+	return nil, fmt.Errorf("could not find message with name '%s' inside the package", strings.TrimPrefix(name, packageName+"."))
 }
 
-// searchMessageByName searches for a protobuf message by its name. It returns
-// both the protogen.Message and a descriptor.DescriptorProto if found.
-func searchPackageMessageByName(file *protogen.File, fullyQualifiedName string) (msg *protogen.Message, msgDescriptor *descriptor.DescriptorProto, err error) {
-	packageName, msgName := splitPackageAndMessage(fullyQualifiedName)
-
-	// Assures that we only search for messages that belongs to the same
-	// package.
-	if packageName != string(file.Desc.FullName()) {
-		return nil, nil, fmt.Errorf("message '%s' does not belong to the current package", fullyQualifiedName)
-	}
-
-	for _, message := range file.Messages {
-		if message.GoIdent.GoName == msgName {
-			msg = message
-			break
+func searchMessageByFullName(messages []*protogen.Message, fullName string) *protogen.Message {
+	for _, message := range messages {
+		if string(message.Desc.FullName()) == fullName {
+			return message
 		}
-	}
 
-	if msg != nil {
-		for _, message := range file.Proto.MessageType {
-			if message.GetName() == msgName {
-				msgDescriptor = message
-				break
-			}
+		if msg := searchMessageByFullName(message.Messages, fullName); msg != nil {
+			return msg
 		}
 	}
 
-	if msg == nil {
-		err = fmt.Errorf("could not find message with name '%s' inside the package", msgName)
-	}
-
-	return
+	return nil
 }
 
-// splitPackageAndMessage splits a protobuf message name into its package name
-// and its proper name.
-func splitPackageAndMessage(messageName string) (string, string) {
-	parts := strings.Split(messageName, ".")
-	return strings.Join(parts[1:len(parts)-1], "."), parts[len(parts)-1]
+// rustTypePath gives the path of a protobuf type relative to its package
+// module in the rust generated code. Nested types are placed inside modules
+// named after their parent messages, like 'outer::Inner'.
+func rustTypePath(desc protoreflect.Descriptor) string {
+	var (
+		packageName = string(desc.ParentFile().Package())
+		name        = strings.TrimPrefix(string(desc.FullName()), packageName+".")
+		parts       = strings.Split(name, ".")
+	)
+
+	for i := 0; i < len(parts)-1; i++ {
+		parts[i] = strcase.ToSnake(parts[i])
+	}
+
+	return strings.Join(parts, "::")
 }
 
 func isIn(haystack []string, needle string) bool {
@@ -1,12 +1,18 @@
 package templates
 
 import (
+	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 
+	"github.com/iancoleman/strcase"
+
 	"github.com/rsfreitas/go-pocket-utils/template"
+	"google.golang.org/protobuf/compiler/protogen"
 
 	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/openapi"
+	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/pocket"
 	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/proto"
 )
 
@@ -15,17 +21,25 @@ type context struct {
 	SingleProtobuf    bool
 	AppName           string
 	Module            string
-	GrpcServiceName   string
 	PackageName       string
 	ProtoFilePath     string
 	OutputDir         string
 	ProtoIncludePaths []string
 	FieldAttributes   []*proto.FieldAttribute
-	Methods           []*proto.Method
+	Services          []*proto.Service
 	Openapi           *openapi.Openapi
 
 	exportOpenapi bool
 	exportRust    bool
+	openapiFormat openapi.Format
+
+	// documentName prefixes the OpenAPI document filename when the context
+	// only generates the document of a single service.
+	documentName string
+
+	// filenamePrefix prefixes the names of all files generated for the
+	// protobuf file.
+	filenamePrefix string
 }
 
 func (c *context) ValidateForExecute() map[string]template.TemplateValidator {
@@ -38,7 +52,10 @@ func (c *context) ValidateForExecute() map[string]template.TemplateValidator {
 			return c.exportRust
 		},
 		"openapi.yaml": func() bool {
-			return c.exportOpenapi
+			return c.exportOpenapi && c.openapiFormat == openapi.Format_YAML
+		},
+		"openapi.json": func() bool {
+			return c.exportOpenapi && c.openapiFormat == openapi.Format_JSON
 		},
 	}
 }
@@ -47,31 +64,56 @@ func (c *context) Extension() string {
 	return ""
 }
 
+// filename gives the name of a file generated with the context.
+func (c *context) filename(name string) string {
+	base := filepath.Base(name)
+	if c.documentName != "" {
+		base = c.documentName + "." + base
+	}
+
+	if c.filenamePrefix != "" {
+		base = c.filenamePrefix + "." + base
+	}
+
+	return filepath.Join(filepath.Dir(name), base)
+}
+
 // IsHttpService checks if the context of the current file corresponds to
 // a HTTP service. To be a service of this type, the protobuf must include
 // at least one endpoint declaration.
 func (c *context) IsHttpService() bool {
-	for _, m := range c.Methods {
-		if m.IsHttp() {
-			return true
+	return len(c.HttpServices()) > 0
+}
+
+// HttpServices gives the services of the file that have at least one
+// endpoint declaration, each one with its own HTTP router.
+func (c *context) HttpServices() []*proto.Service {
+	var services []*proto.Service
+	for _, s := range c.Services {
+		if s.IsHttp() {
+			services = append(services, s)
 		}
 	}
 
-	return false
+	return services
 }
 
-func buildContext(options *LoadOptions) (*context, error) {
-	packageName, err := proto.GetPackageName(options.Plugin)
-	if err != nil {
-		return nil, err
-	}
-	if packageName == "" && err == nil {
-		// Nothing to do here because we don't recognize the file or it does not
-		// have something that we need.
+// buildContexts gives the contexts of the protobuf file templates. Every
+// service has its own context for its OpenAPI document when documents are
+// split by service.
+func buildContexts(options *LoadOptions) ([]*context, error) {
+	var (
+		file          = options.File
+		packageName   = string(file.GoPackageName)
+		protoFilePath = file.Proto.GetName()
+	)
+
+	// Proto file has no service declared. Nothing for us to handle here.
+	if len(file.Services) == 0 {
 		return nil, nil
 	}
 
-	protoFilePath, err := proto.GetProtoFilePath(options.Plugin)
+	fieldNaming, err := pocket.ParseFieldNaming(options.FieldNaming)
 	if err != nil {
 		return nil, err
 	}
@@ -87,39 +129,147 @@ func buildContext(options *LoadOptions) (*context, error) {
 		PackageName:       packageName,
 		ProtoFilePath:     fmt.Sprintf("%v/%v", options.PrototoolPath, protoFilePath),
 		ProtoIncludePaths: options.IncludePaths,
-		FieldAttributes:   proto.GetFieldAttributes(options.Plugin),
-		exportOpenapi:     options.ExportOpenapi,
+		FieldAttributes:   proto.GetFieldAttributes(file, fieldNaming),
+		exportOpenapi:     options.ExportOpenapi && !options.OpenapiAggregate,
 		exportRust:        options.ExportRust,
+		filenamePrefix:    options.FilenamePrefix,
+	}
+
+	spec, err := proto.Parse(file, fieldNaming)
+	if err != nil {
+		return nil, err
+	}
+	ctx.AppName = spec.AppName
+	ctx.Services = spec.Services
+	ctx.Module = filterPackageName(spec.PackageName)
+
+	// Aggregated OpenAPI documents are built by the contexts of their apps.
+	if ctx.exportOpenapi {
+		openapiOptions, format, servicesMode, err := parseOpenapiOptions(options, fieldNaming)
+		if err != nil {
+			return nil, err
+		}
+		ctx.openapiFormat = format
+
+		if servicesMode == openapi.ServicesMode_Split {
+			return serviceDocumentContexts(ctx, file, options.Plugin, openapiOptions)
+		}
+
+		opApi, err := openapi.FromProto(file, options.Plugin, openapiOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		// Files without HTTP services have no document, but still have
+		// their Rust sources.
+		ctx.Openapi = opApi
+		ctx.exportOpenapi = opApi != nil
 	}
 
-	spec, err := proto.Parse(options.Plugin)
+	return []*context{ctx}, nil
+}
+
+// buildAppContext gives the context of the OpenAPI document aggregating all
+// protobuf files of an app.
+func buildAppContext(options *LoadOptions) (*context, error) {
+	fieldNaming, err := pocket.ParseFieldNaming(options.FieldNaming)
 	if err != nil {
 		return nil, err
 	}
-	if spec != nil {
-		ctx.AppName = spec.AppName
-		ctx.Methods = spec.Methods
-		ctx.GrpcServiceName = spec.ServiceName
-		ctx.Module = filterPackageName(spec.PackageName)
+
+	openapiOptions, format, servicesMode, err := parseOpenapiOptions(options, fieldNaming)
+	if err != nil {
+		return nil, err
+	}
+	if servicesMode == openapi.ServicesMode_Split {
+		return nil, errors.New("aggregated OpenAPI documents cannot be split by service")
 	}
 
-	file, err := proto.GetProtoFile(options.Plugin)
+	opApi, err := openapi.FromProtoFiles(options.AppFiles, options.Plugin, openapiOptions)
 	if err != nil {
 		return nil, err
 	}
+	if opApi == nil {
+		return nil, nil
+	}
 
-	if options.ExportOpenapi {
-		opApi, err := openapi.FromProto(file, options.Plugin)
+	return &context{
+		AppName:        pocket.GetFileExtensions(options.AppFiles[0].Proto).AppName,
+		Openapi:        opApi,
+		exportOpenapi:  true,
+		openapiFormat:  format,
+		filenamePrefix: options.FilenamePrefix,
+	}, nil
+}
+
+// parseOpenapiOptions gives the options used to build OpenAPI documents,
+// their format and how they hold the services of a file.
+func parseOpenapiOptions(options *LoadOptions, fieldNaming pocket.FieldNaming) (*openapi.Options, openapi.Format, openapi.ServicesMode, error) {
+	settings, err := openapi.LoadSettings(options.OpenapiSettings)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	schemaNaming, err := openapi.ParseSchemaNaming(options.OpenapiSchemaNaming)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	oneofMode, err := openapi.ParseOneofMode(options.OpenapiOneof)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	version, err := openapi.ParseVersion(options.OpenapiVersion)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	format, err := openapi.ParseFormat(options.OpenapiFormat)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	servicesMode, err := openapi.ParseServicesMode(options.OpenapiServices)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	return &openapi.Options{
+		Settings:            settings,
+		PreferComments:      options.OpenapiPreferComments,
+		SchemaNaming:        schemaNaming,
+		OneofMode:           oneofMode,
+		FieldNaming:         fieldNaming,
+		Version:             version,
+		ServiceOperationIds: options.OpenapiServiceOperationIds,
+	}, format, servicesMode, nil
+}
+
+// serviceDocumentContexts gives the file context, without its OpenAPI
+// document, followed by a context for the document of each service, named
+// after the service.
+func serviceDocumentContexts(ctx *context, file *protogen.File, plugin *protogen.Plugin, options *openapi.Options) ([]*context, error) {
+	var documents []*context
+
+	for _, service := range file.Services {
+		opApi, err := openapi.FromProtoService(file, service, plugin, options)
 		if err != nil {
 			return nil, err
 		}
 		if opApi == nil {
-			return nil, nil
+			continue
 		}
-		ctx.Openapi = opApi
+
+		document := *ctx
+		document.Openapi = opApi
+		document.exportRust = false
+		document.documentName = strcase.ToSnake(service.GoName)
+		documents = append(documents, &document)
 	}
 
-	return ctx, nil
+	ctx.exportOpenapi = false
+	return append([]*context{ctx}, documents...), nil
 }
 
 // filterPackageName retrieves only the last part of a package name.
@@ -3,7 +3,6 @@ package templates
 import (
 	"embed"
 
-	//	"github.com/go-playground/validator/v10"
 	"google.golang.org/protobuf/compiler/protogen"
 
 	"github.com/rsfreitas/go-pocket-utils/template"
@@ -12,21 +11,60 @@ import (
 //go:embed *.tmpl
 var files embed.FS
 
-// TODO: need to validate here for mandatory options
+// LoadOptions gathers the options used to load the templates of a protobuf
+// file or of an app.
 type LoadOptions struct {
-	SingleProtobuf  bool
-	UseRocket       bool
-	ExportOpenapi   bool
-	ExportRust      bool
-	OpenapiSettings string
-	OutputDir       string
-	PrototoolPath   string
-	IncludePaths    []string
-	Plugin          *protogen.Plugin
+	SingleProtobuf             bool
+	UseRocket                  bool
+	ExportOpenapi              bool
+	ExportRust                 bool
+	OpenapiPreferComments      bool
+	OpenapiSettings            string
+	OpenapiSchemaNaming        string
+	OpenapiOneof               string
+	OpenapiVersion             string
+	OpenapiFormat              string
+	OpenapiServices            string
+	OpenapiAggregate           bool
+	OpenapiServiceOperationIds bool
+	FieldNaming                string
+	OutputDir                  string
+	PrototoolPath              string
+	IncludePaths               []string
+	Plugin                     *protogen.Plugin
+
+	// File is the protobuf file, of the plugin, whose templates are loaded.
+	File *protogen.File
+
+	// AppFiles are the protobuf files of an app, whose OpenAPI document is
+	// loaded by LoadApp when documents are aggregated.
+	AppFiles []*protogen.File
+
+	// FilenamePrefix prefixes the names of all generated files, keeping
+	// files of different protobuf files apart.
+	FilenamePrefix string
 }
 
-func Load(options *LoadOptions) (*template.Templates, error) {
-	ctx, err := buildContext(options)
+// Templates holds the templates of a protobuf file, executed once for each
+// of its contexts.
+type Templates struct {
+	contexts  []*context
+	templates []*template.Templates
+}
+
+func Load(options *LoadOptions) (*Templates, error) {
+	contexts, err := buildContexts(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadContexts(contexts)
+}
+
+// LoadApp loads the templates of the OpenAPI document that aggregates all
+// protobuf files of an app.
+func LoadApp(options *LoadOptions) (*Templates, error) {
+	ctx, err := buildAppContext(options)
 	if err != nil {
 		return nil, err
 	}
@@ -34,9 +72,47 @@ func Load(options *LoadOptions) (*template.Templates, error) {
 		return nil, nil
 	}
 
-	return template.LoadTemplates(&template.Options{
-		Plugin:  options.Plugin,
-		Files:   files,
-		Context: ctx,
-	})
+	return loadContexts([]*context{ctx})
+}
+
+func loadContexts(contexts []*context) (*Templates, error) {
+	if len(contexts) == 0 {
+		return nil, nil
+	}
+
+	t := &Templates{}
+	for _, ctx := range contexts {
+		tpl, err := template.LoadTemplates(&template.Options{
+			Files:   files,
+			Context: ctx,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		t.contexts = append(t.contexts, ctx)
+		t.templates = append(t.templates, tpl)
+	}
+
+	return t, nil
+}
+
+// Execute executes the templates of all contexts.
+func (t *Templates) Execute() ([]*template.Generated, error) {
+	var generated []*template.Generated
+
+	for i, tpl := range t.templates {
+		gen, err := tpl.Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, g := range gen {
+			g.Filename = t.contexts[i].filename(g.Filename)
+		}
+
+		generated = append(generated, gen...)
+	}
+
+	return generated, nil
 }
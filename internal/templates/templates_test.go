@@ -0,0 +1,947 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	descriptor "google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	pocketpb "github.com/rsfreitas/protoc-gen-pocket-extensions/options/pocket"
+)
+
+// exampleFile is a service that uses most of the plugin annotations.
+const exampleFile = `
+name: "example.proto"
+package: "service.example.v1"
+dependency: "google/api/annotations.proto"
+dependency: "pocket.proto"
+dependency: "pocket_http.proto"
+dependency: "pocket_openapi.proto"
+options {
+  go_package: "example.com/example/v1;example"
+  [pocket.service.app_name]: "example"
+  [pocket.openapi.title]: "example"
+  [pocket.openapi.version]: "0.1.0"
+  [pocket.openapi.server]: { url: "http://localhost:8080" description: "local" }
+  [pocket.openapi.server]: { url: "https://api.example.com" description: "production" }
+  [pocket.openapi.description]: "The example API."
+  [pocket.openapi.contact]: { name: "Example" email: "example@example.com" }
+  [pocket.openapi.tag]: { name: "examples" description: "Example operations." }
+  [pocket.openapi.tag]: { name: "admin" external_docs: { url: "https://docs.example.com/admin" } }
+}
+message_type {
+  name: "Example"
+  field { name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "id" }
+  field { name: "owner_id" number: 2 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "ownerId" }
+  field { name: "tags" number: 3 label: LABEL_REPEATED type: TYPE_STRING json_name: "tags" }
+  field { name: "labels" number: 4 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".service.example.v1.Example.LabelsEntry" json_name: "labels" }
+  nested_type {
+    name: "LabelsEntry"
+    field { name: "key" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "key" }
+    field { name: "value" number: 2 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "value" }
+    options { map_entry: true }
+  }
+}
+message_type {
+  name: "GetExampleRequest"
+  field { name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "id" }
+  field { name: "page" number: 2 label: LABEL_OPTIONAL type: TYPE_INT32 json_name: "page" options { [pocket.http.field_definitions]: { location: HTTP_FIELD_LOCATION_QUERY } } }
+  field { name: "page_size" number: 3 label: LABEL_OPTIONAL type: TYPE_INT32 json_name: "pageSize" options { [pocket.http.field_definitions]: { location: HTTP_FIELD_LOCATION_QUERY } } }
+  field { name: "request_id" number: 4 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "requestId" options { [pocket.http.field_definitions]: { location: HTTP_FIELD_LOCATION_HEADER } } }
+}
+message_type {
+  name: "GetExampleResponse"
+  field { name: "example" number: 1 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".service.example.v1.Example" json_name: "example" }
+}
+message_type {
+  name: "CreateExampleRequest"
+  field { name: "name" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "name" }
+  field { name: "tags" number: 2 label: LABEL_REPEATED type: TYPE_STRING json_name: "tags" }
+  field { name: "request_id" number: 3 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "requestId" options { [pocket.http.field_definitions]: { location: HTTP_FIELD_LOCATION_HEADER } } }
+}
+service {
+  name: "ExampleService"
+  options {
+    [pocket.http.service_definitions]: {
+      header: { name: "X-Request-Id" member_name: "request_id" }
+      security_scheme: {
+        type: HTTP_SECURITY_SCHEME_HTTP
+        scheme: HTTP_SECURITY_SCHEME_SCHEME_BEARER
+        bearer_format: HTTP_SECURITY_SCHEME_BEARER_FORMAT_JWT
+        description: "A bearer token."
+      }
+    }
+  }
+  method {
+    name: "GetExample"
+    input_type: ".service.example.v1.GetExampleRequest"
+    output_type: ".service.example.v1.GetExampleResponse"
+    options {
+      [google.api.http]: { get: "/example/v1/examples/{id}" }
+      [pocket.http.method_definitions]: { scope: "example:read" scope: "example:list" }
+      [pocket.openapi.operation]: {
+        summary: "Gets an example."
+        tags: "examples"
+        description: "Some: more #details."
+        response: { code: RESPONSE_CODE_OK description: "Success." }
+        response: { code: RESPONSE_CODE_BAD_REQUEST description: "Bad request." }
+        response: { code: RESPONSE_CODE_NOT_FOUND description: "Not found." }
+        response: { code: RESPONSE_CODE_INTERNAL_ERROR description: "Internal error." }
+      }
+    }
+  }
+  method {
+    name: "CreateExample"
+    input_type: ".service.example.v1.CreateExampleRequest"
+    output_type: ".service.example.v1.Example"
+    options {
+      [google.api.http]: { post: "/example/v1/examples" body: "*" }
+      [pocket.http.method_definitions]: { scope: "example:write" }
+      [pocket.openapi.operation]: {
+        summary: "Creates an example."
+        description: "Creates \"quoted\" examples."
+        response: { code: RESPONSE_CODE_CREATED description: "Created." }
+        response: { status: "4XX" description: "Client error." }
+        response: { status: "default" description: "Unexpected error." }
+      }
+    }
+  }
+}
+syntax: "proto3"
+`
+
+// servicesFile declares many services, where only some of them are HTTP
+// services.
+const servicesFile = `
+name: "services.proto"
+package: "service.services.v1"
+dependency: "google/api/annotations.proto"
+dependency: "pocket.proto"
+dependency: "pocket_http.proto"
+dependency: "pocket_openapi.proto"
+options {
+  go_package: "example.com/services/v1;services"
+  [pocket.service.app_name]: "services"
+  [pocket.openapi.title]: "services"
+  [pocket.openapi.version]: "0.1.0"
+}
+message_type {
+  name: "Item"
+  field { name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "id" }
+}
+service {
+  name: "PublicService"
+  options { [pocket.http.service_definitions]: {} }
+  method {
+    name: "GetItem"
+    input_type: ".service.services.v1.Item"
+    output_type: ".service.services.v1.Item"
+    options {
+      [google.api.http]: { get: "/v1/items/{id}" }
+      [pocket.openapi.operation]: { summary: "Gets an item." description: "Gets an item." }
+    }
+  }
+}
+service {
+  name: "AdminService"
+  options {
+    [pocket.http.service_definitions]: {
+      security_scheme: { type: HTTP_SECURITY_SCHEME_HTTP scheme: HTTP_SECURITY_SCHEME_SCHEME_BEARER }
+    }
+  }
+  method {
+    name: "GetItem"
+    input_type: ".service.services.v1.Item"
+    output_type: ".service.services.v1.Item"
+    options {
+      [google.api.http]: { get: "/admin/v1/items/{id}" }
+      [pocket.openapi.operation]: { summary: "Gets an item." description: "Gets an item." }
+    }
+  }
+}
+service {
+  name: "InternalService"
+  method {
+    name: "Ping"
+    input_type: ".service.services.v1.Item"
+    output_type: ".service.services.v1.Item"
+  }
+}
+syntax: "proto3"
+`
+
+// ordersFile is another file of the example app.
+const ordersFile = `
+name: "orders.proto"
+package: "service.orders.v1"
+dependency: "google/api/annotations.proto"
+dependency: "pocket.proto"
+dependency: "pocket_http.proto"
+dependency: "pocket_openapi.proto"
+options {
+  go_package: "example.com/orders/v1;orders"
+  [pocket.service.app_name]: "example"
+  [pocket.openapi.title]: "example"
+  [pocket.openapi.version]: "0.1.0"
+  [pocket.openapi.server]: { url: "https://api.example.com" description: "production" }
+  [pocket.openapi.server]: { url: "https://orders.example.com" }
+  [pocket.openapi.tag]: { name: "orders" }
+}
+message_type {
+  name: "Example"
+  field { name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "id" }
+}
+service {
+  name: "OrderService"
+  options { [pocket.http.service_definitions]: {} }
+  method {
+    name: "GetExample"
+    input_type: ".service.orders.v1.Example"
+    output_type: ".service.orders.v1.Example"
+    options {
+      [google.api.http]: { get: "/orders/v1/examples/{id}" }
+      [pocket.openapi.operation]: {
+        summary: "Gets an example."
+        description: "Gets an example."
+        tags: "orders"
+        response: { code: RESPONSE_CODE_OK description: "Success." }
+      }
+    }
+  }
+}
+syntax: "proto3"
+`
+
+// bodyFile maps request bodies of different verbs.
+const bodyFile = `
+name: "body.proto"
+package: "service.body.v1"
+dependency: "google/api/annotations.proto"
+dependency: "pocket.proto"
+dependency: "pocket_http.proto"
+dependency: "pocket_openapi.proto"
+options {
+  go_package: "example.com/body/v1;body"
+  [pocket.service.app_name]: "body"
+  [pocket.openapi.title]: "body"
+  [pocket.openapi.version]: "0.1.0"
+}
+message_type {
+  name: "Book"
+  field { name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "id" }
+  field { name: "title" number: 2 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "title" }
+}
+message_type {
+  name: "BookRequest"
+  field { name: "book" number: 1 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".service.body.v1.Book" json_name: "book" }
+  field { name: "shelf" number: 2 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "shelf" }
+  field { name: "validate_only" number: 3 label: LABEL_OPTIONAL type: TYPE_BOOL json_name: "validateOnly" }
+}
+service {
+  name: "BookService"
+  options { [pocket.http.service_definitions]: {} }
+  method {
+    name: "CreateBook"
+    input_type: ".service.body.v1.BookRequest"
+    output_type: ".service.body.v1.Book"
+    options {
+      [google.api.http]: { post: "/v1/shelves/{shelf}/books" body: "book" }
+      [pocket.openapi.operation]: { summary: "Creates a book." description: "Creates a book." }
+    }
+  }
+  method {
+    name: "UpdateBook"
+    input_type: ".service.body.v1.BookRequest"
+    output_type: ".service.body.v1.Book"
+    options {
+      [google.api.http]: { patch: "/v1/shelves/{shelf}/books" body: "*" }
+      [pocket.openapi.operation]: { summary: "Updates a book." description: "Updates a book." }
+    }
+  }
+  method {
+    name: "DeleteBook"
+    input_type: ".service.body.v1.BookRequest"
+    output_type: ".service.body.v1.Book"
+    options {
+      [google.api.http]: { delete: "/v1/books/{book.id}" }
+      [pocket.openapi.operation]: { summary: "Deletes a book." description: "Deletes a book." }
+    }
+  }
+}
+syntax: "proto3"
+`
+
+// pathsFile declares endpoints with variable patterns and custom verbs.
+const pathsFile = `
+name: "paths.proto"
+package: "service.paths.v1"
+dependency: "google/api/annotations.proto"
+dependency: "pocket.proto"
+dependency: "pocket_http.proto"
+dependency: "pocket_openapi.proto"
+options {
+  go_package: "example.com/paths/v1;paths"
+  [pocket.service.app_name]: "paths"
+  [pocket.openapi.title]: "paths"
+  [pocket.openapi.version]: "0.1.0"
+}
+message_type {
+  name: "Book"
+  field { name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_INT64 json_name: "id" }
+  field { name: "parent" number: 2 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "parent" }
+  field { name: "path" number: 3 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "path" }
+}
+service {
+  name: "BookService"
+  options { [pocket.http.service_definitions]: {} }
+  method {
+    name: "GetBook"
+    input_type: ".service.paths.v1.Book"
+    output_type: ".service.paths.v1.Book"
+    options {
+      [google.api.http]: { get: "/v1/{parent=publishers/*}/books/{id}" }
+      [pocket.openapi.operation]: { summary: "Gets a book." description: "Gets a book." }
+    }
+  }
+  method {
+    name: "CancelBook"
+    input_type: ".service.paths.v1.Book"
+    output_type: ".service.paths.v1.Book"
+    options {
+      [google.api.http]: { post: "/v1/books/{id}:cancel" }
+      [pocket.openapi.operation]: { summary: "Cancels a book." description: "Cancels a book." }
+    }
+  }
+  method {
+    name: "ArchiveBook"
+    input_type: ".service.paths.v1.Book"
+    output_type: ".service.paths.v1.Book"
+    options {
+      [google.api.http]: { post: "/v1/books/{id}:archive" }
+      [pocket.openapi.operation]: { summary: "Archives a book." description: "Archives a book." }
+    }
+  }
+  method {
+    name: "PublishBook"
+    input_type: ".service.paths.v1.Book"
+    output_type: ".service.paths.v1.Book"
+    options {
+      [google.api.http]: { post: "/v1/{path=files/**}:publish" }
+      [pocket.openapi.operation]: { summary: "Publishes a book." description: "Publishes a book." }
+    }
+  }
+}
+syntax: "proto3"
+`
+
+// commentsFile declares a method and a field whose annotations have no
+// texts, which come from their comments.
+const commentsFile = `
+name: "comments.proto"
+package: "service.comments.v1"
+dependency: "google/api/annotations.proto"
+dependency: "pocket.proto"
+dependency: "pocket_http.proto"
+dependency: "pocket_openapi.proto"
+options {
+  go_package: "example.com/comments/v1;comments"
+  [pocket.service.app_name]: "comments"
+  [pocket.openapi.title]: "comments"
+  [pocket.openapi.version]: "0.1.0"
+}
+message_type {
+  name: "Note"
+  field { name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "id" options { [pocket.openapi.property]: { example: "n-1" } } }
+}
+service {
+  name: "NoteService"
+  options { [pocket.http.service_definitions]: {} }
+  method {
+    name: "GetNote"
+    input_type: ".service.comments.v1.Note"
+    output_type: ".service.comments.v1.Note"
+    options {
+      [google.api.http]: { get: "/v1/notes/{id}" }
+      [pocket.openapi.operation]: { response: { code: RESPONSE_CODE_OK description: "Success." } }
+    }
+  }
+}
+source_code_info {
+  location { path: [4, 0, 2, 0] span: [0, 0, 0] leading_comments: " The note id.\n" }
+  location { path: [6, 0, 2, 0] span: [0, 0, 0] leading_comments: " Gets a note.\n\n By its id.\n" }
+}
+syntax: "proto3"
+`
+
+// newTestPlugin builds a plugin that generates files, from their text format
+// descriptors, with all of their dependencies.
+func newTestPlugin(t *testing.T, contents ...string) *protogen.Plugin {
+	var files []*descriptor.FileDescriptorProto
+	for _, content := range contents {
+		var file descriptor.FileDescriptorProto
+		if err := prototext.Unmarshal([]byte(content), &file); err != nil {
+			t.Fatal(err)
+		}
+
+		files = append(files, &file)
+	}
+
+	request := &pluginpb.CodeGeneratorRequest{}
+	for _, file := range files {
+		request.FileToGenerate = append(request.FileToGenerate, file.GetName())
+	}
+
+	for _, dependency := range []protoreflect.FileDescriptor{
+		descriptor.File_google_protobuf_descriptor_proto,
+		annotations.File_google_api_http_proto,
+		annotations.File_google_api_annotations_proto,
+		pocketpb.File_pocket_proto,
+		pocketpb.File_pocket_http_proto,
+		pocketpb.File_pocket_openapi_proto,
+	} {
+		request.ProtoFile = append(request.ProtoFile, protodesc.ToFileDescriptorProto(dependency))
+	}
+	for _, file := range files {
+		request.ProtoFile = append(request.ProtoFile, proto.Clone(file).(*descriptor.FileDescriptorProto))
+	}
+
+	plugin, err := protogen.Options{}.New(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return plugin
+}
+
+// generate runs all templates over the example file, giving their contents
+// by their file names.
+func generate(t *testing.T, openapiFormat string) map[string]string {
+	return generateFile(t, exampleFile, &LoadOptions{
+		UseRocket:     true,
+		ExportOpenapi: true,
+		ExportRust:    true,
+		OpenapiFormat: openapiFormat,
+	})
+}
+
+// generateFile runs all templates over a file, giving their contents by their
+// file names.
+func generateFile(t *testing.T, content string, options *LoadOptions) map[string]string {
+	options.Plugin = newTestPlugin(t, content)
+	options.File = options.Plugin.Files[len(options.Plugin.Files)-1]
+
+	tpl, err := Load(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gen, err := tpl.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := make(map[string]string)
+	for _, g := range gen {
+		files[filepath.Base(g.Filename)] = g.Data.String()
+	}
+
+	return files
+}
+
+func TestDeterministicOutput(t *testing.T) {
+	for _, format := range []string{"yaml", "json"} {
+		t.Run(format, func(t *testing.T) {
+			var (
+				a        = assert.New(t)
+				expected = generate(t, format)
+			)
+
+			a.Len(expected, 3)
+			a.Contains(expected, "openapi."+format)
+			a.Contains(expected, "http.rs")
+			a.Contains(expected, "build.rs")
+
+			for i := 0; i < 20; i++ {
+				for name, data := range generate(t, format) {
+					a.Equal(expected[name], data, name)
+				}
+			}
+		})
+	}
+}
+
+func TestServices(t *testing.T) {
+	t.Run("merged", func(t *testing.T) {
+		var (
+			a     = assert.New(t)
+			files = generateFile(t, servicesFile, &LoadOptions{
+				UseRocket:                  true,
+				ExportOpenapi:              true,
+				ExportRust:                 true,
+				OpenapiServiceOperationIds: true,
+			})
+		)
+
+		a.Len(files, 3)
+		a.Contains(files["http.rs"], "pub fn public_service_http_router(")
+		a.Contains(files["http.rs"], "pub fn admin_service_http_router(")
+		a.Contains(files["http.rs"], "pub async fn admin_service_get_item_handler(")
+		a.NotContains(files["http.rs"], "internal_service")
+		a.Contains(files["openapi.yaml"], "/v1/items/{id}:")
+		a.Contains(files["openapi.yaml"], "/admin/v1/items/{id}:")
+		a.Contains(files["openapi.yaml"], "operationId: PublicService_GetItem")
+		a.Contains(files["openapi.yaml"], "operationId: AdminService_GetItem")
+
+		// Methods without HTTP definitions don't receive tokens, even when
+		// their services have security schemes.
+		a.NotContains(files["http.rs"], "token:")
+	})
+
+	t.Run("merged without service operation ids", func(t *testing.T) {
+		plugin := newTestPlugin(t, servicesFile)
+		_, err := Load(&LoadOptions{
+			Plugin:        plugin,
+			File:          plugin.Files[len(plugin.Files)-1],
+			ExportOpenapi: true,
+		})
+		assert.ErrorContains(t, err, "operationId 'GetItem' is used by both 'GET /admin/v1/items/{id}' and 'GET /v1/items/{id}'")
+	})
+
+	t.Run("split", func(t *testing.T) {
+		var (
+			a     = assert.New(t)
+			files = generateFile(t, servicesFile, &LoadOptions{
+				ExportOpenapi:   true,
+				OpenapiServices: "split",
+			})
+		)
+
+		a.Len(files, 2)
+		a.Contains(files["public_service.openapi.yaml"], "operationId: GetItem")
+		a.NotContains(files["public_service.openapi.yaml"], "/admin/v1/items/{id}:")
+		a.Contains(files["admin_service.openapi.yaml"], "operationId: GetItem")
+		a.Contains(files["admin_service.openapi.yaml"], "securitySchemes:")
+
+		// Only the document holds the default security requirements.
+		a.Contains(files["admin_service.openapi.yaml"], "\nsecurity:\n- authorization: []\n")
+		a.NotContains(files["admin_service.openapi.yaml"], "      security:")
+		a.NotContains(files["public_service.openapi.yaml"], "security:")
+	})
+}
+
+func TestFilenamePrefix(t *testing.T) {
+	var (
+		a     = assert.New(t)
+		files = generateFile(t, exampleFile, &LoadOptions{
+			UseRocket:      true,
+			ExportOpenapi:  true,
+			ExportRust:     true,
+			FilenamePrefix: "example",
+		})
+	)
+
+	a.Len(files, 3)
+	a.Contains(files, "example.openapi.yaml")
+	a.Contains(files, "example.http.rs")
+	a.Contains(files, "example.build.rs")
+}
+
+func TestWithoutDocument(t *testing.T) {
+	// Services without their definitions have no OpenAPI document, but still
+	// have their handlers.
+	content := strings.Replace(exampleFile, `  options {
+    [pocket.http.service_definitions]: {
+      header: { name: "X-Request-Id" member_name: "request_id" }
+      security_scheme: {
+        type: HTTP_SECURITY_SCHEME_HTTP
+        scheme: HTTP_SECURITY_SCHEME_SCHEME_BEARER
+        bearer_format: HTTP_SECURITY_SCHEME_BEARER_FORMAT_JWT
+        description: "A bearer token."
+      }
+    }
+  }
+`, "", 1)
+
+	for _, services := range []string{"merged", "split"} {
+		t.Run(services, func(t *testing.T) {
+			files := generateFile(t, content, &LoadOptions{
+				UseRocket:       true,
+				ExportOpenapi:   true,
+				ExportRust:      true,
+				OpenapiServices: services,
+			})
+
+			a := assert.New(t)
+			a.Len(files, 2)
+			a.Contains(files, "http.rs")
+			a.Contains(files, "build.rs")
+		})
+	}
+}
+
+func TestBodyMapping(t *testing.T) {
+	var (
+		a     = assert.New(t)
+		files = generateFile(t, bodyFile, &LoadOptions{
+			UseRocket:     true,
+			ExportOpenapi: true,
+			ExportRust:    true,
+		})
+		document = files["openapi.yaml"]
+		handlers = files["http.rs"]
+	)
+
+	// A field body sends only that field, leaving the others in the query.
+	a.Contains(document, `    post:
+      summary: Creates a book.
+      description: Creates a book.
+      operationId: CreateBook
+      parameters:
+      - in: path
+        name: shelf
+        required: true
+        schema:
+          type: string
+      - in: query
+        name: validate_only
+        required: false
+        schema:
+          type: boolean
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Book'
+`)
+	a.Contains(handlers, `#[post("/v1/shelves/<shelf>/books?<validate_only>", format = "application/json", data = "<req>")]`)
+	a.Contains(handlers, "req: rocket::serde::json::Json<crate::v1::Book>,")
+	a.Contains(handlers, "book: Some(req.into_inner()),")
+
+	// A "*" body sends every field not bound by the path.
+	a.Contains(document, `    patch:
+      summary: Updates a book.
+      description: Updates a book.
+      operationId: UpdateBook
+      parameters:
+      - in: path
+        name: shelf
+        required: true
+        schema:
+          type: string
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/BookRequest'
+`)
+	a.Contains(handlers, `#[patch("/v1/shelves/<shelf>/books", format = "application/json", data = "<req>")]`)
+	a.Contains(handlers, "req: rocket::serde::json::Json<crate::v1::BookRequest>,")
+	a.Contains(handlers, "    let mut body = req.into_inner();\n    body.shelf = shelf.clone();\n")
+
+	// Without a body, the fields of inner messages not bound by the path are
+	// query parameters too.
+	a.Contains(document, `    delete:
+      summary: Deletes a book.
+      description: Deletes a book.
+      operationId: DeleteBook
+      parameters:
+      - in: query
+        name: book.title
+        required: false
+        schema:
+          type: string
+      - in: query
+        name: shelf
+        required: false
+        schema:
+          type: string
+      - in: query
+        name: validate_only
+        required: false
+        schema:
+          type: boolean
+      - in: path
+        name: book.id
+        required: true
+        schema:
+          type: string
+      responses:
+        "200":
+          description: Success.
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Book'
+`)
+	a.Contains(handlers, "#[derive(rocket::form::FromForm)]\npub struct DeleteBookBookQuery {\n    title: String,\n}\n")
+	a.Contains(handlers, `#[delete("/v1/books/<book_id>?<book>&<shelf>&<validate_only>")]`)
+	a.Contains(handlers, "    book_id: String,\n    book: DeleteBookBookQuery,\n    shelf: String,\n")
+	a.Contains(handlers, `    let mut body = crate::v1::BookRequest {
+        shelf: shelf.clone(),
+        validate_only: validate_only,
+        ..Default::default()
+    };
+    body.book.get_or_insert_with(Default::default).id = book_id.clone();
+    body.book.get_or_insert_with(Default::default).title = book.title;
+`)
+}
+
+func TestAggregate(t *testing.T) {
+	// executeApp runs the app templates over files, giving their contents by
+	// their file names.
+	executeApp := func(t *testing.T, options *LoadOptions, contents ...string) (map[string]string, error) {
+		plugin := newTestPlugin(t, contents...)
+		options.Plugin = plugin
+		options.AppFiles = plugin.Files[len(plugin.Files)-len(contents):]
+		options.ExportOpenapi = true
+		options.ExportRust = true
+		options.OpenapiAggregate = true
+
+		tpl, err := LoadApp(options)
+		if err != nil {
+			return nil, err
+		}
+
+		gen, err := tpl.Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		files := make(map[string]string)
+		for _, g := range gen {
+			files[g.Filename] = g.Data.String()
+		}
+
+		return files, nil
+	}
+
+	t.Run("merged files", func(t *testing.T) {
+		a := assert.New(t)
+		files, err := executeApp(t, &LoadOptions{OpenapiServiceOperationIds: true}, exampleFile, ordersFile)
+		a.NoError(err)
+
+		a.Len(files, 1)
+		document := files["openapi.yaml"]
+		a.Contains(document, "title: example")
+		a.Contains(document, "version: 0.1.0")
+		a.Contains(document, "/example/v1/examples/{id}:")
+		a.Contains(document, "/orders/v1/examples/{id}:")
+		a.Contains(document, "operationId: ExampleService_GetExample")
+		a.Contains(document, "operationId: OrderService_GetExample")
+		a.Contains(document, "service.example.v1.Example:")
+		a.Contains(document, "service.orders.v1.Example:")
+		a.Contains(document, "- name: orders")
+		a.Contains(document, "url: https://orders.example.com")
+		a.Equal(1, strings.Count(document, "url: https://api.example.com"))
+	})
+
+	t.Run("conflicting paths", func(t *testing.T) {
+		_, err := executeApp(t, &LoadOptions{}, exampleFile, strings.Replace(ordersFile, "/orders/v1/examples", "/example/v1/examples", 1))
+		assert.ErrorContains(t, err, "declares the endpoint 'GET /example/v1/examples/{id}' of another service")
+	})
+
+	t.Run("conflicting operation ids", func(t *testing.T) {
+		_, err := executeApp(t, &LoadOptions{}, exampleFile, ordersFile)
+		assert.ErrorContains(t, err, "operationId 'GetExample' is used by both 'GET /example/v1/examples/{id}' and 'GET /orders/v1/examples/{id}'")
+
+		_, err = executeApp(t, &LoadOptions{OpenapiServiceOperationIds: true}, exampleFile, strings.Replace(ordersFile, "OrderService", "ExampleService", 1))
+		assert.ErrorContains(t, err, "operationId 'ExampleService_GetExample' is used by both")
+	})
+
+	t.Run("conflicting info", func(t *testing.T) {
+		a := assert.New(t)
+		orders := strings.Replace(ordersFile, `[pocket.openapi.version]: "0.1.0"`, `[pocket.openapi.version]: "0.2.0"`, 1)
+
+		_, err := executeApp(t, &LoadOptions{OpenapiServiceOperationIds: true}, exampleFile, orders)
+		a.ErrorContains(err, "files 'example.proto' and 'orders.proto' declare different versions, '0.1.0' and '0.2.0'")
+
+		// The settings file may replace them.
+		settings := filepath.Join(t.TempDir(), "settings.yaml")
+		if err := os.WriteFile(settings, []byte("info:\n  version: 1.0.0\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		files, err := executeApp(t, &LoadOptions{OpenapiServiceOperationIds: true, OpenapiSettings: settings}, exampleFile, orders)
+		a.NoError(err)
+		a.Contains(files["openapi.yaml"], "version: 1.0.0")
+	})
+}
+
+func TestDuplicateEndpoints(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		binding  string
+		expected string
+	}{
+		{
+			name:     "same method",
+			binding:  `post: "/example/v1/examples" body: "*" additional_bindings { post: "/example/v1/examples" body: "*" }`,
+			expected: "method 'CreateExample' declares the endpoint 'POST /example/v1/examples' more than once",
+		},
+		{
+			name:     "different methods",
+			binding:  `post: "/example/v1/examples" body: "*" additional_bindings { get: "/example/v1/examples/{id}" }`,
+			expected: "methods 'GetExample' and 'CreateExample' declare the same endpoint 'GET /example/v1/examples/{id}'",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				content = strings.Replace(exampleFile, `post: "/example/v1/examples" body: "*"`, test.binding, 1)
+				plugin  = newTestPlugin(t, content)
+			)
+
+			_, err := Load(&LoadOptions{
+				Plugin:        plugin,
+				File:          plugin.Files[len(plugin.Files)-1],
+				ExportOpenapi: true,
+			})
+			assert.ErrorContains(t, err, test.expected)
+		})
+	}
+}
+
+func TestPathTemplates(t *testing.T) {
+	var (
+		a     = assert.New(t)
+		files = generateFile(t, pathsFile, &LoadOptions{
+			UseRocket:     true,
+			ExportOpenapi: true,
+			ExportRust:    true,
+		})
+		rust = files["http.rs"]
+	)
+
+	a.Contains(files["openapi.yaml"], "/v1/{parent}/books/{id}:")
+	a.Contains(rust, `#[get("/v1/publishers/<parent>/books/<id>?<path>")]`)
+	a.Contains(rust, `parent: format!("publishers/{}", parent),`)
+
+	// Custom verbs are matched by the routes, which need ranks of their own.
+	a.Contains(rust, `#[post("/v1/books/<id>?<parent>&<path>", rank = -13)]`)
+	a.Contains(rust, `#[post("/v1/books/<id>?<parent>&<path>", rank = -14)]`)
+	a.Contains(rust, "impl<'r> rocket::request::FromParam<'r> for CancelBookSegment {")
+	a.Contains(rust, `param.strip_suffix(":cancel")`)
+	a.Contains(rust, "    id: CancelBookSegment,\n")
+	a.Contains(rust, "    id: ArchiveBookSegment,\n")
+	a.Contains(rust, `#[post("/v1/<path..>?<id>&<parent>", rank = -15)]`)
+	a.Contains(rust, "impl<'r> rocket::request::FromSegments<'r> for PublishBookSegment {")
+	a.Contains(rust, "        path: path.0,\n")
+
+	// Values that cannot be parsed are rejected.
+	a.Contains(rust, "id: id.0.parse().map_err(|_| rocket::http::Status::BadRequest)?,")
+	a.NotContains(rust, "unwrap_or_default")
+	a.NotContains(rust, "trim_end_matches")
+}
+
+func TestRenamedQueryParameters(t *testing.T) {
+	var (
+		a       = assert.New(t)
+		content = strings.Replace(exampleFile, `json_name: "page" options`, `json_name: "type" options`, 1)
+		files   = generateFile(t, content, &LoadOptions{
+			UseRocket:   true,
+			ExportRust:  true,
+			FieldNaming: "json_name",
+		})
+		rust = files["http.rs"]
+	)
+
+	// Query parameters are named after their JSON names by a form, keeping
+	// their proto names as identifiers.
+	a.Contains(rust, `#[get("/example/v1/examples/<id>?<query..>")]`)
+	a.Contains(rust, "#[derive(rocket::form::FromForm)]\npub struct GetExampleQuery {")
+	a.Contains(rust, "    #[field(name = \"type\")]\n    page: i32,\n")
+	a.Contains(rust, "    #[field(name = \"pageSize\")]\n    page_size: i32,\n")
+	a.Contains(rust, "    query: GetExampleQuery,\n")
+	a.Contains(rust, "        page_size: query.page_size,\n")
+	a.NotContains(rust, "non_snake_case")
+	a.NotContains(rust, "pageSize:")
+}
+
+func TestFieldLocations(t *testing.T) {
+	var (
+		a       = assert.New(t)
+		content = strings.Replace(exampleFile, `  field { name: "request_id" number: 3 `, `  field { name: "validate_only" number: 4 label: LABEL_OPTIONAL type: TYPE_BOOL json_name: "validateOnly" options { [pocket.http.field_definitions]: { location: HTTP_FIELD_LOCATION_QUERY } } }
+  field { name: "request_id" number: 3 `, 1)
+		files = generateFile(t, content, &LoadOptions{
+			UseRocket:     true,
+			ExportOpenapi: true,
+			ExportRust:    true,
+		})
+		document = files["openapi.yaml"]
+		rust     = files["http.rs"]
+	)
+
+	// Header fields are not read from the query.
+	a.Contains(document, `      - in: query
+        name: page_size
+        required: false
+        schema:
+          type: integer
+          format: int32
+      - in: header
+        name: X-Request-Id
+`)
+	a.Contains(rust, `#[get("/example/v1/examples/<id>?<page>&<page_size>")]`)
+
+	// Query fields are not read from a "*" body.
+	a.Contains(document, `      parameters:
+      - in: query
+        name: validate_only
+        required: false
+        schema:
+          type: boolean
+      - in: header
+        name: X-Request-Id
+`)
+	a.Contains(rust, `#[post("/example/v1/examples?<validate_only>", format = "application/json", data = "<req>")]`)
+	a.Contains(rust, "    let mut body = req.into_inner();\n    body.validate_only = validate_only;\n")
+}
+
+func TestPartialAnnotations(t *testing.T) {
+	var (
+		a     = assert.New(t)
+		files = generateFile(t, commentsFile, &LoadOptions{
+			ExportOpenapi: true,
+		})
+		document = files["openapi.yaml"]
+	)
+
+	// Annotations without texts use the comments.
+	a.Contains(document, `      summary: Gets a note.
+      description: By its id.
+      operationId: GetNote
+`)
+	a.Contains(document, `        id:
+          type: string
+          description: The note id.
+          example: n-1
+`)
+}
+
+func TestDuplicateTags(t *testing.T) {
+	var (
+		content = strings.Replace(exampleFile,
+			`[pocket.openapi.tag]: { name: "admin"`,
+			`[pocket.openapi.tag]: { name: "examples" }
+  [pocket.openapi.tag]: { name: "admin"`, 1)
+		plugin = newTestPlugin(t, content)
+	)
+
+	_, err := Load(&LoadOptions{
+		Plugin:        plugin,
+		File:          plugin.Files[len(plugin.Files)-1],
+		ExportOpenapi: true,
+	})
+	assert.ErrorContains(t, err, "file 'example.proto': tag 'examples' declared more than once")
+}
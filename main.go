@@ -3,11 +3,12 @@ package main
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	gengo "google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
 	"google.golang.org/protobuf/compiler/protogen"
 
-	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/proto"
+	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/pocket"
 	"github.com/rsfreitas/protoc-gen-pocket-extensions/internal/templates"
 )
 
@@ -18,42 +19,187 @@ func main() {
 		ParamFunc: options.FlagsSet(),
 	}.Run(func(plugin *protogen.Plugin) error {
 		plugin.SupportedFeatures = gengo.SupportedFeatures
-		tpl, err := templates.Load(&templates.LoadOptions{
-			Plugin:          plugin,
-			SingleProtobuf:  options.SingleProtobuf(),
-			OutputDir:       options.OutputDir(),
-			PrototoolPath:   options.PrototoolPath(),
-			IncludePaths:    options.IncludePaths(),
-			UseRocket:       options.Rocket(),
-			ExportOpenapi:   options.ExportOpenapi(),
-			ExportRust:      options.ExportRust(),
-			OpenapiSettings: options.OpenapiSettings(),
-		})
-		if err != nil {
-			return fmt.Errorf("%v: %w", plugin.Request.FileToGenerate[len(plugin.Request.FileToGenerate)-1], err)
-		}
-		if tpl == nil {
-			return nil
-		}
-
-		gen, err := tpl.Execute()
-		if err != nil {
-			return fmt.Errorf("%v: %w", plugin.Request.FileToGenerate[len(plugin.Request.FileToGenerate)-1], err)
-		}
-
-		for _, template := range gen {
-			filePath, _ := proto.GetProtoFilePath(plugin)
-			filename := filepath.Join(
-				filepath.Dir(filePath),
-				filepath.Base(template.Filename),
-			)
-
-			f := plugin.NewGeneratedFile(filename, ".")
-			if _, err := f.Write(template.Data.Bytes()); err != nil {
-				return err
+		prefixes := filenamePrefixes(plugin)
+
+		for _, file := range plugin.Files {
+			if !file.Generate {
+				continue
+			}
+
+			if err := generateFile(plugin, file, options, prefixes[file]); err != nil {
+				return fmt.Errorf("%v: %w", file.Proto.GetName(), err)
+			}
+		}
+
+		if options.ExportOpenapi() && options.OpenapiAggregate() {
+			names, apps := appFiles(plugin)
+			prefixes := appFilenamePrefixes(names, apps)
+
+			for _, name := range names {
+				if err := generateApp(plugin, apps[name], options, prefixes[name]); err != nil {
+					return fmt.Errorf("app '%v': %w", name, err)
+				}
 			}
 		}
 
 		return nil
 	})
 }
+
+// newLoadOptions gives the template options shared by all generated files.
+func newLoadOptions(plugin *protogen.Plugin, options *pluginOptions) *templates.LoadOptions {
+	return &templates.LoadOptions{
+		Plugin:                     plugin,
+		SingleProtobuf:             options.SingleProtobuf(),
+		OutputDir:                  options.OutputDir(),
+		PrototoolPath:              options.PrototoolPath(),
+		IncludePaths:               options.IncludePaths(),
+		UseRocket:                  options.Rocket(),
+		ExportOpenapi:              options.ExportOpenapi(),
+		ExportRust:                 options.ExportRust(),
+		OpenapiSettings:            options.OpenapiSettings(),
+		OpenapiPreferComments:      options.OpenapiPreferComments(),
+		OpenapiSchemaNaming:        options.OpenapiSchemaNaming(),
+		OpenapiOneof:               options.OpenapiOneof(),
+		OpenapiVersion:             options.OpenapiVersion(),
+		OpenapiFormat:              options.OpenapiFormat(),
+		OpenapiServices:            options.OpenapiServices(),
+		OpenapiAggregate:           options.OpenapiAggregate(),
+		OpenapiServiceOperationIds: options.OpenapiServiceOperationIds(),
+		FieldNaming:                options.FieldNaming(),
+	}
+}
+
+// generateFile generates all templates of a protobuf file, beside it.
+func generateFile(plugin *protogen.Plugin, file *protogen.File, options *pluginOptions, prefix string) error {
+	loadOptions := newLoadOptions(plugin, options)
+	loadOptions.File = file
+	loadOptions.FilenamePrefix = prefix
+
+	tpl, err := templates.Load(loadOptions)
+	if err != nil {
+		return err
+	}
+
+	return writeTemplates(plugin, tpl, filepath.Dir(file.Proto.GetName()))
+}
+
+// generateApp generates the OpenAPI document of an app, beside its first
+// protobuf file.
+func generateApp(plugin *protogen.Plugin, files []*protogen.File, options *pluginOptions, prefix string) error {
+	loadOptions := newLoadOptions(plugin, options)
+	loadOptions.AppFiles = files
+	loadOptions.FilenamePrefix = prefix
+
+	tpl, err := templates.LoadApp(loadOptions)
+	if err != nil {
+		return err
+	}
+
+	return writeTemplates(plugin, tpl, filepath.Dir(files[0].Proto.GetName()))
+}
+
+// writeTemplates executes templates, writing their files inside a
+// directory.
+func writeTemplates(plugin *protogen.Plugin, tpl *templates.Templates, dir string) error {
+	if tpl == nil {
+		return nil
+	}
+
+	gen, err := tpl.Execute()
+	if err != nil {
+		return err
+	}
+
+	for _, template := range gen {
+		f := plugin.NewGeneratedFile(filepath.Join(dir, template.Filename), ".")
+		if _, err := f.Write(template.Data.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// appFiles gives the generated protobuf files with services of every app,
+// by their app names, together with the app names in the order they
+// appear.
+func appFiles(plugin *protogen.Plugin) ([]string, map[string][]*protogen.File) {
+	var (
+		names []string
+		apps  = make(map[string][]*protogen.File)
+	)
+
+	for _, file := range plugin.Files {
+		if !file.Generate || len(file.Services) == 0 {
+			continue
+		}
+
+		name := pocket.GetFileExtensions(file.Proto).AppName
+		if _, ok := apps[name]; !ok {
+			names = append(names, name)
+		}
+
+		apps[name] = append(apps[name], file)
+	}
+
+	return names, apps
+}
+
+// appFilenamePrefixes gives the prefixes of the OpenAPI documents of apps
+// that share their directories with other apps, which are named after their
+// apps, like 'example.openapi.yaml'.
+func appFilenamePrefixes(names []string, apps map[string][]*protogen.File) map[string]string {
+	var (
+		prefixes = make(map[string]string)
+		dirs     = make(map[string][]string)
+	)
+
+	for _, name := range names {
+		dir := filepath.Dir(apps[name][0].Proto.GetName())
+		dirs[dir] = append(dirs[dir], name)
+	}
+
+	for _, dirNames := range dirs {
+		if len(dirNames) < 2 {
+			continue
+		}
+
+		for _, name := range dirNames {
+			prefixes[name] = name
+		}
+	}
+
+	return prefixes
+}
+
+// filenamePrefixes gives the prefixes of the generated files of protobuf
+// files that share their directories with other generated files with
+// services, which are named after their protobuf files, like
+// 'example.openapi.yaml'.
+func filenamePrefixes(plugin *protogen.Plugin) map[*protogen.File]string {
+	var (
+		prefixes = make(map[*protogen.File]string)
+		dirs     = make(map[string][]*protogen.File)
+	)
+
+	for _, file := range plugin.Files {
+		if file.Generate && len(file.Services) > 0 {
+			dir := filepath.Dir(file.Proto.GetName())
+			dirs[dir] = append(dirs[dir], file)
+		}
+	}
+
+	for _, files := range dirs {
+		if len(files) < 2 {
+			continue
+		}
+
+		for _, file := range files {
+			name := filepath.Base(file.Proto.GetName())
+			prefixes[file] = strings.TrimSuffix(name, filepath.Ext(name))
+		}
+	}
+
+	return prefixes
+}
@@ -6,16 +6,25 @@ import (
 )
 
 type pluginOptions struct {
-	exportOpenapi           *bool
-	exportRust              *bool
-	axumFramework           *bool
-	rocketFramework         *bool
-	singleProtobuf          *bool
-	includePaths            *string
-	outputDir               *string
-	prototoolRootPath       *string
-	openapiSettingsFilename *string
-	flags                   flag.FlagSet
+	exportOpenapi              *bool
+	exportRust                 *bool
+	axumFramework              *bool
+	rocketFramework            *bool
+	singleProtobuf             *bool
+	includePaths               *string
+	outputDir                  *string
+	prototoolRootPath          *string
+	openapiSettingsFilename    *string
+	openapiPreferComments      *bool
+	openapiSchemaNaming        *string
+	openapiOneof               *string
+	openapiVersion             *string
+	openapiFormat              *string
+	openapiServices            *string
+	openapiAggregate           *bool
+	openapiServiceOperationIds *bool
+	fieldNaming                *string
+	flags                      flag.FlagSet
 }
 
 func (p *pluginOptions) FlagsSet() func(string, string) error {
@@ -62,6 +71,42 @@ func (p *pluginOptions) OpenapiSettings() string {
 	return *p.openapiSettingsFilename
 }
 
+func (p *pluginOptions) OpenapiPreferComments() bool {
+	return *p.openapiPreferComments
+}
+
+func (p *pluginOptions) OpenapiSchemaNaming() string {
+	return *p.openapiSchemaNaming
+}
+
+func (p *pluginOptions) OpenapiOneof() string {
+	return *p.openapiOneof
+}
+
+func (p *pluginOptions) OpenapiVersion() string {
+	return *p.openapiVersion
+}
+
+func (p *pluginOptions) OpenapiFormat() string {
+	return *p.openapiFormat
+}
+
+func (p *pluginOptions) OpenapiServices() string {
+	return *p.openapiServices
+}
+
+func (p *pluginOptions) OpenapiAggregate() bool {
+	return *p.openapiAggregate
+}
+
+func (p *pluginOptions) OpenapiServiceOperationIds() bool {
+	return *p.openapiServiceOperationIds
+}
+
+func (p *pluginOptions) FieldNaming() string {
+	return *p.fieldNaming
+}
+
 func newPluginOptions() *pluginOptions {
 	o := &pluginOptions{}
 
@@ -74,6 +119,15 @@ func newPluginOptions() *pluginOptions {
 	o.exportOpenapi = o.flags.Bool("openapi", false, "Enables/Disables openapi generation.")
 	o.exportRust = o.flags.Bool("rust", false, "Enables/Disables rust source code generation.")
 	o.openapiSettingsFilename = o.flags.String("openapi_settings", "", "Sets the OpenAPI additional settings file.")
+	o.openapiSchemaNaming = o.flags.String("openapi_schema_naming", "auto", "Sets how OpenAPI schemas are named: short, package or auto.")
+	o.fieldNaming = o.flags.String("field_naming", "proto", "Sets how fields are named in their JSON form: proto, json_name or camel.")
+	o.openapiVersion = o.flags.String("openapi_version", "3.0", "Sets the OpenAPI version of the generated document: 3.0 or 3.1.")
+	o.openapiFormat = o.flags.String("openapi_format", "yaml", "Sets the format of the generated OpenAPI document: yaml or json.")
+	o.openapiServices = o.flags.String("openapi_services", "merged", "Sets how OpenAPI documents hold the services of a file: merged or split.")
+	o.openapiAggregate = o.flags.Bool("openapi_aggregate", false, "Aggregates the OpenAPI documents of all files of an app into a single document.")
+	o.openapiServiceOperationIds = o.flags.Bool("openapi_service_operation_ids", false, "Prefixes OpenAPI operation ids by the names of their services.")
+	o.openapiOneof = o.flags.String("openapi_oneof", "oneof", "Sets how OpenAPI schemas represent oneof fields: oneof or extension.")
+	o.openapiPreferComments = o.flags.Bool("openapi_prefer_comments", false, "Makes OpenAPI descriptions from proto comments take precedence over annotated ones.")
 
 	return o
 }
@@ -341,8 +341,10 @@ type HttpService struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header         []*HttpParameter    `protobuf:"bytes,1,rep,name=header" json:"header,omitempty"`
-	SecurityScheme *HttpSecurityScheme `protobuf:"bytes,2,opt,name=security_scheme,json=securityScheme" json:"security_scheme,omitempty"`
+	Header []*HttpParameter `protobuf:"bytes,1,rep,name=header" json:"header,omitempty"`
+	// Sets the authentication schemes of the service. Schemes must have unique
+	// names when more than one is declared.
+	SecurityScheme []*HttpSecurityScheme `protobuf:"bytes,2,rep,name=security_scheme,json=securityScheme" json:"security_scheme,omitempty"`
 }
 
 func (x *HttpService) Reset() {
@@ -384,7 +386,7 @@ func (x *HttpService) GetHeader() []*HttpParameter {
 	return nil
 }
 
-func (x *HttpService) GetSecurityScheme() *HttpSecurityScheme {
+func (x *HttpService) GetSecurityScheme() []*HttpSecurityScheme {
 	if x != nil {
 		return x.SecurityScheme
 	}
@@ -399,6 +401,10 @@ type HttpMethod struct {
 	NoAuth *bool            `protobuf:"varint,1,opt,name=no_auth,json=noAuth" json:"no_auth,omitempty"`
 	Scope  []string         `protobuf:"bytes,2,rep,name=scope" json:"scope,omitempty"`
 	Header []*HttpParameter `protobuf:"bytes,3,rep,name=header" json:"header,omitempty"`
+	// Sets which security schemes apply to the method. The method is
+	// authorized when any of its requirements is satisfied. When not set, any
+	// of the service schemes, with the method scopes, is required.
+	Security []*HttpSecurityRequirement `protobuf:"bytes,4,rep,name=security" json:"security,omitempty"`
 }
 
 func (x *HttpMethod) Reset() {
@@ -454,6 +460,117 @@ func (x *HttpMethod) GetHeader() []*HttpParameter {
 	return nil
 }
 
+func (x *HttpMethod) GetSecurity() []*HttpSecurityRequirement {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+// A set of security schemes that must all be satisfied together.
+type HttpSecurityRequirement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scheme []*HttpSecurityRequirementScheme `protobuf:"bytes,1,rep,name=scheme" json:"scheme,omitempty"`
+}
+
+func (x *HttpSecurityRequirement) Reset() {
+	*x = HttpSecurityRequirement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_http_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpSecurityRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpSecurityRequirement) ProtoMessage() {}
+
+func (x *HttpSecurityRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_http_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpSecurityRequirement.ProtoReflect.Descriptor instead.
+func (*HttpSecurityRequirement) Descriptor() ([]byte, []int) {
+	return file_pocket_http_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HttpSecurityRequirement) GetScheme() []*HttpSecurityRequirementScheme {
+	if x != nil {
+		return x.Scheme
+	}
+	return nil
+}
+
+type HttpSecurityRequirementScheme struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of a service security scheme.
+	Name  *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Scope []string `protobuf:"bytes,2,rep,name=scope" json:"scope,omitempty"`
+}
+
+func (x *HttpSecurityRequirementScheme) Reset() {
+	*x = HttpSecurityRequirementScheme{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_http_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpSecurityRequirementScheme) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpSecurityRequirementScheme) ProtoMessage() {}
+
+func (x *HttpSecurityRequirementScheme) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_http_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpSecurityRequirementScheme.ProtoReflect.Descriptor instead.
+func (*HttpSecurityRequirementScheme) Descriptor() ([]byte, []int) {
+	return file_pocket_http_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HttpSecurityRequirementScheme) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *HttpSecurityRequirementScheme) GetScope() []string {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
 type HttpParameter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -468,7 +585,7 @@ type HttpParameter struct {
 func (x *HttpParameter) Reset() {
 	*x = HttpParameter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_http_proto_msgTypes[2]
+		mi := &file_pocket_http_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -481,7 +598,7 @@ func (x *HttpParameter) String() string {
 func (*HttpParameter) ProtoMessage() {}
 
 func (x *HttpParameter) ProtoReflect() protoreflect.Message {
-	mi := &file_pocket_http_proto_msgTypes[2]
+	mi := &file_pocket_http_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -494,7 +611,7 @@ func (x *HttpParameter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpParameter.ProtoReflect.Descriptor instead.
 func (*HttpParameter) Descriptor() ([]byte, []int) {
-	return file_pocket_http_proto_rawDescGZIP(), []int{2}
+	return file_pocket_http_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *HttpParameter) GetName() string {
@@ -536,12 +653,18 @@ type HttpSecurityScheme struct {
 	In           *string                         `protobuf:"bytes,4,opt,name=in" json:"in,omitempty"`
 	Scheme       *HttpSecuritySchemeScheme       `protobuf:"varint,5,opt,name=scheme,enum=pocket.http.HttpSecuritySchemeScheme" json:"scheme,omitempty"`
 	BearerFormat *HttpSecuritySchemeBearerFormat `protobuf:"varint,6,opt,name=bearer_format,json=bearerFormat,enum=pocket.http.HttpSecuritySchemeBearerFormat" json:"bearer_format,omitempty"`
+	// Sets the scheme name, which defaults to "authorization".
+	SchemeName *string `protobuf:"bytes,7,opt,name=scheme_name,json=schemeName" json:"scheme_name,omitempty"`
+	// Sets the flows of HTTP_SECURITY_SCHEME_OAUTH2 schemes.
+	Flows *HttpSecuritySchemeOauthFlows `protobuf:"bytes,8,opt,name=flows" json:"flows,omitempty"`
+	// Sets the discovery URL of HTTP_SECURITY_SCHEME_OPEN_ID_CONNECT schemes.
+	OpenIdConnectUrl *string `protobuf:"bytes,9,opt,name=open_id_connect_url,json=openIdConnectUrl" json:"open_id_connect_url,omitempty"`
 }
 
 func (x *HttpSecurityScheme) Reset() {
 	*x = HttpSecurityScheme{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_http_proto_msgTypes[3]
+		mi := &file_pocket_http_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -554,7 +677,7 @@ func (x *HttpSecurityScheme) String() string {
 func (*HttpSecurityScheme) ProtoMessage() {}
 
 func (x *HttpSecurityScheme) ProtoReflect() protoreflect.Message {
-	mi := &file_pocket_http_proto_msgTypes[3]
+	mi := &file_pocket_http_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +690,7 @@ func (x *HttpSecurityScheme) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpSecurityScheme.ProtoReflect.Descriptor instead.
 func (*HttpSecurityScheme) Descriptor() ([]byte, []int) {
-	return file_pocket_http_proto_rawDescGZIP(), []int{3}
+	return file_pocket_http_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *HttpSecurityScheme) GetType() HttpSecuritySchemeType {
@@ -612,6 +735,224 @@ func (x *HttpSecurityScheme) GetBearerFormat() HttpSecuritySchemeBearerFormat {
 	return HttpSecuritySchemeBearerFormat_HTTP_SECURITY_SCHEME_BEARER_FORMAT_UNSPECIFIED
 }
 
+func (x *HttpSecurityScheme) GetSchemeName() string {
+	if x != nil && x.SchemeName != nil {
+		return *x.SchemeName
+	}
+	return ""
+}
+
+func (x *HttpSecurityScheme) GetFlows() *HttpSecuritySchemeOauthFlows {
+	if x != nil {
+		return x.Flows
+	}
+	return nil
+}
+
+func (x *HttpSecurityScheme) GetOpenIdConnectUrl() string {
+	if x != nil && x.OpenIdConnectUrl != nil {
+		return *x.OpenIdConnectUrl
+	}
+	return ""
+}
+
+type HttpSecuritySchemeOauthFlows struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Implicit          *HttpSecuritySchemeOauthFlow `protobuf:"bytes,1,opt,name=implicit" json:"implicit,omitempty"`
+	Password          *HttpSecuritySchemeOauthFlow `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+	ClientCredentials *HttpSecuritySchemeOauthFlow `protobuf:"bytes,3,opt,name=client_credentials,json=clientCredentials" json:"client_credentials,omitempty"`
+	AuthorizationCode *HttpSecuritySchemeOauthFlow `protobuf:"bytes,4,opt,name=authorization_code,json=authorizationCode" json:"authorization_code,omitempty"`
+}
+
+func (x *HttpSecuritySchemeOauthFlows) Reset() {
+	*x = HttpSecuritySchemeOauthFlows{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_http_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpSecuritySchemeOauthFlows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpSecuritySchemeOauthFlows) ProtoMessage() {}
+
+func (x *HttpSecuritySchemeOauthFlows) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_http_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpSecuritySchemeOauthFlows.ProtoReflect.Descriptor instead.
+func (*HttpSecuritySchemeOauthFlows) Descriptor() ([]byte, []int) {
+	return file_pocket_http_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HttpSecuritySchemeOauthFlows) GetImplicit() *HttpSecuritySchemeOauthFlow {
+	if x != nil {
+		return x.Implicit
+	}
+	return nil
+}
+
+func (x *HttpSecuritySchemeOauthFlows) GetPassword() *HttpSecuritySchemeOauthFlow {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *HttpSecuritySchemeOauthFlows) GetClientCredentials() *HttpSecuritySchemeOauthFlow {
+	if x != nil {
+		return x.ClientCredentials
+	}
+	return nil
+}
+
+func (x *HttpSecuritySchemeOauthFlows) GetAuthorizationCode() *HttpSecuritySchemeOauthFlow {
+	if x != nil {
+		return x.AuthorizationCode
+	}
+	return nil
+}
+
+type HttpSecuritySchemeOauthFlow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorizationUrl *string                         `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl" json:"authorization_url,omitempty"`
+	TokenUrl         *string                         `protobuf:"bytes,2,opt,name=token_url,json=tokenUrl" json:"token_url,omitempty"`
+	RefreshUrl       *string                         `protobuf:"bytes,3,opt,name=refresh_url,json=refreshUrl" json:"refresh_url,omitempty"`
+	Scope            []*HttpSecuritySchemeOauthScope `protobuf:"bytes,4,rep,name=scope" json:"scope,omitempty"`
+}
+
+func (x *HttpSecuritySchemeOauthFlow) Reset() {
+	*x = HttpSecuritySchemeOauthFlow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_http_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpSecuritySchemeOauthFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpSecuritySchemeOauthFlow) ProtoMessage() {}
+
+func (x *HttpSecuritySchemeOauthFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_http_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpSecuritySchemeOauthFlow.ProtoReflect.Descriptor instead.
+func (*HttpSecuritySchemeOauthFlow) Descriptor() ([]byte, []int) {
+	return file_pocket_http_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HttpSecuritySchemeOauthFlow) GetAuthorizationUrl() string {
+	if x != nil && x.AuthorizationUrl != nil {
+		return *x.AuthorizationUrl
+	}
+	return ""
+}
+
+func (x *HttpSecuritySchemeOauthFlow) GetTokenUrl() string {
+	if x != nil && x.TokenUrl != nil {
+		return *x.TokenUrl
+	}
+	return ""
+}
+
+func (x *HttpSecuritySchemeOauthFlow) GetRefreshUrl() string {
+	if x != nil && x.RefreshUrl != nil {
+		return *x.RefreshUrl
+	}
+	return ""
+}
+
+func (x *HttpSecuritySchemeOauthFlow) GetScope() []*HttpSecuritySchemeOauthScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+type HttpSecuritySchemeOauthScope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Description *string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+}
+
+func (x *HttpSecuritySchemeOauthScope) Reset() {
+	*x = HttpSecuritySchemeOauthScope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_http_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpSecuritySchemeOauthScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpSecuritySchemeOauthScope) ProtoMessage() {}
+
+func (x *HttpSecuritySchemeOauthScope) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_http_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpSecuritySchemeOauthScope.ProtoReflect.Descriptor instead.
+func (*HttpSecuritySchemeOauthScope) Descriptor() ([]byte, []int) {
+	return file_pocket_http_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HttpSecuritySchemeOauthScope) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *HttpSecuritySchemeOauthScope) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
 type HttpFieldProperty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -623,7 +964,7 @@ type HttpFieldProperty struct {
 func (x *HttpFieldProperty) Reset() {
 	*x = HttpFieldProperty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_http_proto_msgTypes[4]
+		mi := &file_pocket_http_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -636,7 +977,7 @@ func (x *HttpFieldProperty) String() string {
 func (*HttpFieldProperty) ProtoMessage() {}
 
 func (x *HttpFieldProperty) ProtoReflect() protoreflect.Message {
-	mi := &file_pocket_http_proto_msgTypes[4]
+	mi := &file_pocket_http_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +990,7 @@ func (x *HttpFieldProperty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpFieldProperty.ProtoReflect.Descriptor instead.
 func (*HttpFieldProperty) Descriptor() ([]byte, []int) {
-	return file_pocket_http_proto_rawDescGZIP(), []int{4}
+	return file_pocket_http_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *HttpFieldProperty) GetLocation() HttpFieldLocation {
@@ -716,128 +1057,192 @@ var file_pocket_http_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70,
 	0x2e, 0x48, 0x74, 0x74, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x06,
 	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x74, 0x79, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x1f, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74,
 	0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
 	0x52, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
-	0x22, 0x6f, 0x0a, 0x0a, 0x48, 0x74, 0x74, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x6e, 0x6f, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x6e, 0x6f, 0x41, 0x75, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x32, 0x0a,
-	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x22, 0x94, 0x01, 0x0a, 0x0d, 0x48, 0x74, 0x74, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e,
-	0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0xa4, 0x02, 0x0a, 0x12, 0x48, 0x74, 0x74,
-	0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12,
-	0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x23, 0x2e,
-	0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70,
-	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x3d,
-	0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
+	0x22, 0xb1, 0x01, 0x0a, 0x0a, 0x48, 0x74, 0x74, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x6e, 0x6f, 0x41, 0x75, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x32,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74,
-	0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x50, 0x0a,
-	0x0d, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74,
-	0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x52, 0x0c, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22,
-	0x4f, 0x0a, 0x11, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e,
-	0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2a, 0x78, 0x0a, 0x11, 0x48, 0x74, 0x74, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x50, 0x41,
-	0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x48, 0x54,
-	0x54, 0x50, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x48, 0x54,
-	0x54, 0x50, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x2a, 0xca, 0x01, 0x0a, 0x16, 0x48,
+	0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x40, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74,
+	0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0x5d, 0x0a, 0x17, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x42, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74,
+	0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x06, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x1d, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x94,
+	0x01, 0x0a, 0x0d, 0x48, 0x74, 0x74, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74,
+	0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0xb5, 0x03, 0x0a, 0x12, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x3d, 0x0a, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x70, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x62, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2b, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0c,
+	0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a,
+	0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x4f, 0x61, 0x75,
+	0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x2d,
+	0x0a, 0x13, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x70, 0x65,
+	0x6e, 0x49, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x22, 0xdc, 0x02,
+	0x0a, 0x1c, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x44,
+	0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48,
 	0x74, 0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45,
-	0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x48,
+	0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x12, 0x44, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x57, 0x0a, 0x12, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77,
+	0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x12, 0x57, 0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74,
+	0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xc9, 0x01, 0x0a,
+	0x1b, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x2b, 0x0a, 0x11,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x3f, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x54, 0x0a, 0x1c, 0x48, 0x74, 0x74, 0x70,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x4f, 0x61,
+	0x75, 0x74, 0x68, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4f,
+	0x0a, 0x11, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68,
+	0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a,
+	0x78, 0x0a, 0x11, 0x48, 0x74, 0x74, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x50, 0x41, 0x52,
+	0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x48, 0x54, 0x54,
+	0x50, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x48, 0x54, 0x54,
+	0x50, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x2a, 0xca, 0x01, 0x0a, 0x16, 0x48, 0x74,
+	0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43,
+	0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x54,
+	0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45,
+	0x4d, 0x45, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
+	0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43,
+	0x48, 0x45, 0x4d, 0x45, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x48,
 	0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48,
-	0x45, 0x4d, 0x45, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x01, 0x12, 0x1d, 0x0a,
-	0x19, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53,
-	0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b,
+	0x45, 0x4d, 0x45, 0x5f, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x32, 0x10, 0x03, 0x12, 0x28, 0x0a, 0x24,
 	0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43,
-	0x48, 0x45, 0x4d, 0x45, 0x5f, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x32, 0x10, 0x03, 0x12, 0x28, 0x0a,
-	0x24, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53,
-	0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x43, 0x4f,
-	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x04, 0x2a, 0xe5, 0x01, 0x0a, 0x18, 0x48, 0x74, 0x74, 0x70,
-	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x27, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43,
-	0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x53, 0x43, 0x48,
-	0x45, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x25, 0x0a, 0x21, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49,
-	0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45,
-	0x5f, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x48, 0x54, 0x54, 0x50,
-	0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45,
-	0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x42, 0x45, 0x41, 0x52, 0x45, 0x52, 0x10, 0x03,
-	0x12, 0x26, 0x0a, 0x22, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54,
+	0x48, 0x45, 0x4d, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x43, 0x4f, 0x4e,
+	0x4e, 0x45, 0x43, 0x54, 0x10, 0x04, 0x2a, 0xe5, 0x01, 0x0a, 0x18, 0x48, 0x74, 0x74, 0x70, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x27, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x55,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45,
+	0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x25, 0x0a, 0x21, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54,
 	0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f,
-	0x44, 0x49, 0x47, 0x45, 0x53, 0x54, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x48, 0x54, 0x54, 0x50,
-	0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45,
-	0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x05, 0x2a,
-	0x80, 0x01, 0x0a, 0x1e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x12, 0x32, 0x0a, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52,
-	0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x42, 0x45, 0x41, 0x52, 0x45,
-	0x52, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53,
-	0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x42,
-	0x45, 0x41, 0x52, 0x45, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x57, 0x54,
-	0x10, 0x01, 0x2a, 0x8e, 0x01, 0x0a, 0x11, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x48, 0x54, 0x54, 0x50,
-	0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x42, 0x4f, 0x44, 0x59, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x46,
-	0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x45,
-	0x41, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x46,
-	0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41,
-	0x54, 0x48, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x46, 0x49, 0x45,
-	0x4c, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x52,
-	0x59, 0x10, 0x03, 0x3a, 0x6c, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x64,
-	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x89, 0xd2, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74,
-	0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x12, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x3a, 0x68, 0x0a, 0x12, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x64, 0x65, 0x66, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x89, 0xd2, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74,
-	0x74, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x11, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x6c, 0x0a, 0x11, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x89, 0xd2, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
-	0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x10, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x49, 0x5a, 0x47, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x73, 0x66, 0x72, 0x65, 0x69, 0x74, 0x61,
-	0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x70, 0x6f, 0x63,
-	0x6b, 0x65, 0x74, 0x2d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x3b, 0x70, 0x6f,
-	0x63, 0x6b, 0x65, 0x74,
+	0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x48, 0x54, 0x54, 0x50, 0x5f,
+	0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f,
+	0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x42, 0x45, 0x41, 0x52, 0x45, 0x52, 0x10, 0x03, 0x12,
+	0x26, 0x0a, 0x22, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59,
+	0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x44,
+	0x49, 0x47, 0x45, 0x53, 0x54, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x48, 0x54, 0x54, 0x50, 0x5f,
+	0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f,
+	0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x05, 0x2a, 0x80,
+	0x01, 0x0a, 0x1e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x32, 0x0a, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49,
+	0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x42, 0x45, 0x41, 0x52, 0x45, 0x52,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x53, 0x45,
+	0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x42, 0x45,
+	0x41, 0x52, 0x45, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x57, 0x54, 0x10,
+	0x01, 0x2a, 0x8e, 0x01, 0x0a, 0x11, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x48, 0x54, 0x54, 0x50, 0x5f,
+	0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42,
+	0x4f, 0x44, 0x59, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x45, 0x41,
+	0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x54,
+	0x48, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x46, 0x49, 0x45, 0x4c,
+	0x44, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59,
+	0x10, 0x03, 0x3a, 0x6c, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x89, 0xd2, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x3a, 0x68, 0x0a, 0x12, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x89, 0xd2, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74,
+	0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x11, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x6c, 0x0a, 0x11, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x89,
+	0xd2, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x10, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x49, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x73, 0x66, 0x72, 0x65, 0x69, 0x74, 0x61, 0x73,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x70, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x2d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x3b, 0x70, 0x6f, 0x63,
+	0x6b, 0x65, 0x74,
 }
 
 var (
@@ -853,42 +1258,55 @@ func file_pocket_http_proto_rawDescGZIP() []byte {
 }
 
 var file_pocket_http_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_pocket_http_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pocket_http_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_pocket_http_proto_goTypes = []interface{}{
-	(HttpParameterType)(0),              // 0: pocket.http.HttpParameterType
-	(HttpSecuritySchemeType)(0),         // 1: pocket.http.HttpSecuritySchemeType
-	(HttpSecuritySchemeScheme)(0),       // 2: pocket.http.HttpSecuritySchemeScheme
-	(HttpSecuritySchemeBearerFormat)(0), // 3: pocket.http.HttpSecuritySchemeBearerFormat
-	(HttpFieldLocation)(0),              // 4: pocket.http.HttpFieldLocation
-	(*HttpService)(nil),                 // 5: pocket.http.HttpService
-	(*HttpMethod)(nil),                  // 6: pocket.http.HttpMethod
-	(*HttpParameter)(nil),               // 7: pocket.http.HttpParameter
-	(*HttpSecurityScheme)(nil),          // 8: pocket.http.HttpSecurityScheme
-	(*HttpFieldProperty)(nil),           // 9: pocket.http.HttpFieldProperty
-	(*descriptorpb.ServiceOptions)(nil), // 10: google.protobuf.ServiceOptions
-	(*descriptorpb.MethodOptions)(nil),  // 11: google.protobuf.MethodOptions
-	(*descriptorpb.FieldOptions)(nil),   // 12: google.protobuf.FieldOptions
+	(HttpParameterType)(0),                // 0: pocket.http.HttpParameterType
+	(HttpSecuritySchemeType)(0),           // 1: pocket.http.HttpSecuritySchemeType
+	(HttpSecuritySchemeScheme)(0),         // 2: pocket.http.HttpSecuritySchemeScheme
+	(HttpSecuritySchemeBearerFormat)(0),   // 3: pocket.http.HttpSecuritySchemeBearerFormat
+	(HttpFieldLocation)(0),                // 4: pocket.http.HttpFieldLocation
+	(*HttpService)(nil),                   // 5: pocket.http.HttpService
+	(*HttpMethod)(nil),                    // 6: pocket.http.HttpMethod
+	(*HttpSecurityRequirement)(nil),       // 7: pocket.http.HttpSecurityRequirement
+	(*HttpSecurityRequirementScheme)(nil), // 8: pocket.http.HttpSecurityRequirementScheme
+	(*HttpParameter)(nil),                 // 9: pocket.http.HttpParameter
+	(*HttpSecurityScheme)(nil),            // 10: pocket.http.HttpSecurityScheme
+	(*HttpSecuritySchemeOauthFlows)(nil),  // 11: pocket.http.HttpSecuritySchemeOauthFlows
+	(*HttpSecuritySchemeOauthFlow)(nil),   // 12: pocket.http.HttpSecuritySchemeOauthFlow
+	(*HttpSecuritySchemeOauthScope)(nil),  // 13: pocket.http.HttpSecuritySchemeOauthScope
+	(*HttpFieldProperty)(nil),             // 14: pocket.http.HttpFieldProperty
+	(*descriptorpb.ServiceOptions)(nil),   // 15: google.protobuf.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),    // 16: google.protobuf.MethodOptions
+	(*descriptorpb.FieldOptions)(nil),     // 17: google.protobuf.FieldOptions
 }
 var file_pocket_http_proto_depIdxs = []int32{
-	7,  // 0: pocket.http.HttpService.header:type_name -> pocket.http.HttpParameter
-	8,  // 1: pocket.http.HttpService.security_scheme:type_name -> pocket.http.HttpSecurityScheme
-	7,  // 2: pocket.http.HttpMethod.header:type_name -> pocket.http.HttpParameter
-	0,  // 3: pocket.http.HttpParameter.type:type_name -> pocket.http.HttpParameterType
-	1,  // 4: pocket.http.HttpSecurityScheme.type:type_name -> pocket.http.HttpSecuritySchemeType
-	2,  // 5: pocket.http.HttpSecurityScheme.scheme:type_name -> pocket.http.HttpSecuritySchemeScheme
-	3,  // 6: pocket.http.HttpSecurityScheme.bearer_format:type_name -> pocket.http.HttpSecuritySchemeBearerFormat
-	4,  // 7: pocket.http.HttpFieldProperty.location:type_name -> pocket.http.HttpFieldLocation
-	10, // 8: pocket.http.service_definitions:extendee -> google.protobuf.ServiceOptions
-	11, // 9: pocket.http.method_definitions:extendee -> google.protobuf.MethodOptions
-	12, // 10: pocket.http.field_definitions:extendee -> google.protobuf.FieldOptions
-	5,  // 11: pocket.http.service_definitions:type_name -> pocket.http.HttpService
-	6,  // 12: pocket.http.method_definitions:type_name -> pocket.http.HttpMethod
-	9,  // 13: pocket.http.field_definitions:type_name -> pocket.http.HttpFieldProperty
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	11, // [11:14] is the sub-list for extension type_name
-	8,  // [8:11] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	9,  // 0: pocket.http.HttpService.header:type_name -> pocket.http.HttpParameter
+	10, // 1: pocket.http.HttpService.security_scheme:type_name -> pocket.http.HttpSecurityScheme
+	9,  // 2: pocket.http.HttpMethod.header:type_name -> pocket.http.HttpParameter
+	7,  // 3: pocket.http.HttpMethod.security:type_name -> pocket.http.HttpSecurityRequirement
+	8,  // 4: pocket.http.HttpSecurityRequirement.scheme:type_name -> pocket.http.HttpSecurityRequirementScheme
+	0,  // 5: pocket.http.HttpParameter.type:type_name -> pocket.http.HttpParameterType
+	1,  // 6: pocket.http.HttpSecurityScheme.type:type_name -> pocket.http.HttpSecuritySchemeType
+	2,  // 7: pocket.http.HttpSecurityScheme.scheme:type_name -> pocket.http.HttpSecuritySchemeScheme
+	3,  // 8: pocket.http.HttpSecurityScheme.bearer_format:type_name -> pocket.http.HttpSecuritySchemeBearerFormat
+	11, // 9: pocket.http.HttpSecurityScheme.flows:type_name -> pocket.http.HttpSecuritySchemeOauthFlows
+	12, // 10: pocket.http.HttpSecuritySchemeOauthFlows.implicit:type_name -> pocket.http.HttpSecuritySchemeOauthFlow
+	12, // 11: pocket.http.HttpSecuritySchemeOauthFlows.password:type_name -> pocket.http.HttpSecuritySchemeOauthFlow
+	12, // 12: pocket.http.HttpSecuritySchemeOauthFlows.client_credentials:type_name -> pocket.http.HttpSecuritySchemeOauthFlow
+	12, // 13: pocket.http.HttpSecuritySchemeOauthFlows.authorization_code:type_name -> pocket.http.HttpSecuritySchemeOauthFlow
+	13, // 14: pocket.http.HttpSecuritySchemeOauthFlow.scope:type_name -> pocket.http.HttpSecuritySchemeOauthScope
+	4,  // 15: pocket.http.HttpFieldProperty.location:type_name -> pocket.http.HttpFieldLocation
+	15, // 16: pocket.http.service_definitions:extendee -> google.protobuf.ServiceOptions
+	16, // 17: pocket.http.method_definitions:extendee -> google.protobuf.MethodOptions
+	17, // 18: pocket.http.field_definitions:extendee -> google.protobuf.FieldOptions
+	5,  // 19: pocket.http.service_definitions:type_name -> pocket.http.HttpService
+	6,  // 20: pocket.http.method_definitions:type_name -> pocket.http.HttpMethod
+	14, // 21: pocket.http.field_definitions:type_name -> pocket.http.HttpFieldProperty
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	19, // [19:22] is the sub-list for extension type_name
+	16, // [16:19] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_pocket_http_proto_init() }
@@ -922,7 +1340,7 @@ func file_pocket_http_proto_init() {
 			}
 		}
 		file_pocket_http_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HttpParameter); i {
+			switch v := v.(*HttpSecurityRequirement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -934,7 +1352,7 @@ func file_pocket_http_proto_init() {
 			}
 		}
 		file_pocket_http_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HttpSecurityScheme); i {
+			switch v := v.(*HttpSecurityRequirementScheme); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -946,6 +1364,66 @@ func file_pocket_http_proto_init() {
 			}
 		}
 		file_pocket_http_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_http_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpSecurityScheme); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_http_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpSecuritySchemeOauthFlows); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_http_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpSecuritySchemeOauthFlow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_http_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpSecuritySchemeOauthScope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_http_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HttpFieldProperty); i {
 			case 0:
 				return &v.state
@@ -964,7 +1442,7 @@ func file_pocket_http_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pocket_http_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   5,
+			NumMessages:   10,
 			NumExtensions: 3,
 			NumServices:   0,
 		},
@@ -28,6 +28,69 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Supported types of a header value.
+type HeaderType int32
+
+const (
+	HeaderType_HEADER_TYPE_STRING  HeaderType = 0
+	HeaderType_HEADER_TYPE_INTEGER HeaderType = 1
+	HeaderType_HEADER_TYPE_NUMBER  HeaderType = 2
+	HeaderType_HEADER_TYPE_BOOLEAN HeaderType = 3
+)
+
+// Enum value maps for HeaderType.
+var (
+	HeaderType_name = map[int32]string{
+		0: "HEADER_TYPE_STRING",
+		1: "HEADER_TYPE_INTEGER",
+		2: "HEADER_TYPE_NUMBER",
+		3: "HEADER_TYPE_BOOLEAN",
+	}
+	HeaderType_value = map[string]int32{
+		"HEADER_TYPE_STRING":  0,
+		"HEADER_TYPE_INTEGER": 1,
+		"HEADER_TYPE_NUMBER":  2,
+		"HEADER_TYPE_BOOLEAN": 3,
+	}
+)
+
+func (x HeaderType) Enum() *HeaderType {
+	p := new(HeaderType)
+	*p = x
+	return p
+}
+
+func (x HeaderType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HeaderType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pocket_openapi_proto_enumTypes[0].Descriptor()
+}
+
+func (HeaderType) Type() protoreflect.EnumType {
+	return &file_pocket_openapi_proto_enumTypes[0]
+}
+
+func (x HeaderType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *HeaderType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = HeaderType(num)
+	return nil
+}
+
+// Deprecated: Use HeaderType.Descriptor instead.
+func (HeaderType) EnumDescriptor() ([]byte, []int) {
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{0}
+}
+
 // All supported HTTP response codes.
 type ResponseCode int32
 
@@ -74,11 +137,11 @@ func (x ResponseCode) String() string {
 }
 
 func (ResponseCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_pocket_openapi_proto_enumTypes[0].Descriptor()
+	return file_pocket_openapi_proto_enumTypes[1].Descriptor()
 }
 
 func (ResponseCode) Type() protoreflect.EnumType {
-	return &file_pocket_openapi_proto_enumTypes[0]
+	return &file_pocket_openapi_proto_enumTypes[1]
 }
 
 func (x ResponseCode) Number() protoreflect.EnumNumber {
@@ -97,7 +160,7 @@ func (x *ResponseCode) UnmarshalJSON(b []byte) error {
 
 // Deprecated: Use ResponseCode.Descriptor instead.
 func (ResponseCode) EnumDescriptor() ([]byte, []int) {
-	return file_pocket_openapi_proto_rawDescGZIP(), []int{0}
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{1}
 }
 
 // Supported formats of a property.
@@ -158,11 +221,11 @@ func (x PropertyFormat) String() string {
 }
 
 func (PropertyFormat) Descriptor() protoreflect.EnumDescriptor {
-	return file_pocket_openapi_proto_enumTypes[1].Descriptor()
+	return file_pocket_openapi_proto_enumTypes[2].Descriptor()
 }
 
 func (PropertyFormat) Type() protoreflect.EnumType {
-	return &file_pocket_openapi_proto_enumTypes[1]
+	return &file_pocket_openapi_proto_enumTypes[2]
 }
 
 func (x PropertyFormat) Number() protoreflect.EnumNumber {
@@ -181,9 +244,260 @@ func (x *PropertyFormat) UnmarshalJSON(b []byte) error {
 
 // Deprecated: Use PropertyFormat.Descriptor instead.
 func (PropertyFormat) EnumDescriptor() ([]byte, []int) {
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{2}
+}
+
+// OpenapiContact defines the contact information of an API.
+type OpenapiContact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Url   *string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+	Email *string `protobuf:"bytes,3,opt,name=email" json:"email,omitempty"`
+}
+
+func (x *OpenapiContact) Reset() {
+	*x = OpenapiContact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_openapi_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiContact) ProtoMessage() {}
+
+func (x *OpenapiContact) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_openapi_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiContact.ProtoReflect.Descriptor instead.
+func (*OpenapiContact) Descriptor() ([]byte, []int) {
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OpenapiContact) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *OpenapiContact) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *OpenapiContact) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+// OpenapiExternalDocs references an external documentation.
+type OpenapiExternalDocs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url         *string `protobuf:"bytes,1,req,name=url" json:"url,omitempty"`
+	Description *string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+}
+
+func (x *OpenapiExternalDocs) Reset() {
+	*x = OpenapiExternalDocs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_openapi_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiExternalDocs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiExternalDocs) ProtoMessage() {}
+
+func (x *OpenapiExternalDocs) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_openapi_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiExternalDocs.ProtoReflect.Descriptor instead.
+func (*OpenapiExternalDocs) Descriptor() ([]byte, []int) {
 	return file_pocket_openapi_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *OpenapiExternalDocs) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *OpenapiExternalDocs) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// OpenapiTag describes a tag used to group operations.
+type OpenapiTag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         *string              `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Description  *string              `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	ExternalDocs *OpenapiExternalDocs `protobuf:"bytes,3,opt,name=external_docs,json=externalDocs" json:"external_docs,omitempty"`
+}
+
+func (x *OpenapiTag) Reset() {
+	*x = OpenapiTag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_openapi_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiTag) ProtoMessage() {}
+
+func (x *OpenapiTag) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_openapi_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiTag.ProtoReflect.Descriptor instead.
+func (*OpenapiTag) Descriptor() ([]byte, []int) {
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OpenapiTag) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *OpenapiTag) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *OpenapiTag) GetExternalDocs() *OpenapiExternalDocs {
+	if x != nil {
+		return x.ExternalDocs
+	}
+	return nil
+}
+
+// OpenapiLicense defines the license of an API.
+type OpenapiLicense struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Url  *string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+	// identifier sets the SPDX license expression of the API, like
+	// "Apache-2.0". It is only used by OpenAPI 3.1 documents, where it cannot
+	// be used together with url.
+	Identifier *string `protobuf:"bytes,3,opt,name=identifier" json:"identifier,omitempty"`
+}
+
+func (x *OpenapiLicense) Reset() {
+	*x = OpenapiLicense{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_openapi_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiLicense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiLicense) ProtoMessage() {}
+
+func (x *OpenapiLicense) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_openapi_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiLicense.ProtoReflect.Descriptor instead.
+func (*OpenapiLicense) Descriptor() ([]byte, []int) {
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OpenapiLicense) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *OpenapiLicense) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *OpenapiLicense) GetIdentifier() string {
+	if x != nil && x.Identifier != nil {
+		return *x.Identifier
+	}
+	return ""
+}
+
 // OpenapiServer defines information that a server to be used by the OpenAPI
 // document must have.
 type OpenapiServer struct {
@@ -200,7 +514,7 @@ type OpenapiServer struct {
 func (x *OpenapiServer) Reset() {
 	*x = OpenapiServer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_openapi_proto_msgTypes[0]
+		mi := &file_pocket_openapi_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -213,7 +527,123 @@ func (x *OpenapiServer) String() string {
 func (*OpenapiServer) ProtoMessage() {}
 
 func (x *OpenapiServer) ProtoReflect() protoreflect.Message {
-	mi := &file_pocket_openapi_proto_msgTypes[0]
+	mi := &file_pocket_openapi_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiServer.ProtoReflect.Descriptor instead.
+func (*OpenapiServer) Descriptor() ([]byte, []int) {
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OpenapiServer) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *OpenapiServer) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type OpenapiService struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// response defines responses shared by all operations of the service. They
+	// take precedence over file responses with the same names.
+	Response []*SharedResponse `protobuf:"bytes,1,rep,name=response" json:"response,omitempty"`
+	// header defines headers shared by all responses of the service. They take
+	// precedence over file headers with the same names.
+	Header []*ResponseHeader `protobuf:"bytes,2,rep,name=header" json:"header,omitempty"`
+}
+
+func (x *OpenapiService) Reset() {
+	*x = OpenapiService{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_openapi_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiService) ProtoMessage() {}
+
+func (x *OpenapiService) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_openapi_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiService.ProtoReflect.Descriptor instead.
+func (*OpenapiService) Descriptor() ([]byte, []int) {
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OpenapiService) GetResponse() []*SharedResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *OpenapiService) GetHeader() []*ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+// SharedResponse is a response declared once and used by many operations.
+// The code and the status of the response are not used.
+type SharedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     *string   `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Response *Response `protobuf:"bytes,2,req,name=response" json:"response,omitempty"`
+}
+
+func (x *SharedResponse) Reset() {
+	*x = SharedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_openapi_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedResponse) ProtoMessage() {}
+
+func (x *SharedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_openapi_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -224,53 +654,270 @@ func (x *OpenapiServer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenapiServer.ProtoReflect.Descriptor instead.
-func (*OpenapiServer) Descriptor() ([]byte, []int) {
-	return file_pocket_openapi_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use SharedResponse.ProtoReflect.Descriptor instead.
+func (*SharedResponse) Descriptor() ([]byte, []int) {
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SharedResponse) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *SharedResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type OpenapiMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// summary and description default to the method comments when they are
+	// not set.
+	Summary     *string     `protobuf:"bytes,1,opt,name=summary" json:"summary,omitempty"`
+	Description *string     `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Tags        []string    `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty"`
+	Response    []*Response `protobuf:"bytes,4,rep,name=response" json:"response,omitempty"`
+	// webhook makes the method a webhook with this name, i.e., a POST request
+	// sent by the API with the method input as its body. Webhooks are only
+	// supported by OpenAPI 3.1 documents.
+	Webhook *string `protobuf:"bytes,5,opt,name=webhook" json:"webhook,omitempty"`
+	// sunset sets the date, like "2025-12-31", after which a deprecated method
+	// may be removed. It requires the method to set the deprecated option.
+	Sunset *string `protobuf:"bytes,6,opt,name=sunset" json:"sunset,omitempty"`
+}
+
+func (x *OpenapiMethod) Reset() {
+	*x = OpenapiMethod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_openapi_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenapiMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenapiMethod) ProtoMessage() {}
+
+func (x *OpenapiMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_openapi_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenapiMethod.ProtoReflect.Descriptor instead.
+func (*OpenapiMethod) Descriptor() ([]byte, []int) {
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OpenapiMethod) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+func (x *OpenapiMethod) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *OpenapiMethod) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *OpenapiMethod) GetResponse() []*Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *OpenapiMethod) GetWebhook() string {
+	if x != nil && x.Webhook != nil {
+		return *x.Webhook
+	}
+	return ""
+}
+
+func (x *OpenapiMethod) GetSunset() string {
+	if x != nil && x.Sunset != nil {
+		return *x.Sunset
+	}
+	return ""
+}
+
+type Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code sets one of the most common HTTP status codes of the response. It is
+	// not used when status is set.
+	Code *ResponseCode `protobuf:"varint,1,opt,name=code,enum=pocket.openapi.ResponseCode" json:"code,omitempty"`
+	// description is required unless the response is a ref.
+	Description *string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	// status sets the HTTP status code of the response, like "202" or "409",
+	// a range of status codes, like "4XX", or "default" for all status codes
+	// not declared by the operation.
+	Status *string `protobuf:"bytes,3,opt,name=status" json:"status,omitempty"`
+	// body sets the message used as the response body, by its fully-qualified
+	// name or by its name inside the file package. The plugin error schemas,
+	// ValidationError and DefaultError, can also be used.
+	Body *string `protobuf:"bytes,4,opt,name=body" json:"body,omitempty"`
+	// no_body removes the response body.
+	NoBody *bool `protobuf:"varint,5,opt,name=no_body,json=noBody" json:"no_body,omitempty"`
+	// ref uses a shared response, by its name, instead of declaring one.
+	Ref *string `protobuf:"bytes,6,opt,name=ref" json:"ref,omitempty"`
+	// header declares the headers that the response sets.
+	Header []*ResponseHeader `protobuf:"bytes,7,rep,name=header" json:"header,omitempty"`
+	// example declares named examples of the response body.
+	Example []*ResponseExample `protobuf:"bytes,8,rep,name=example" json:"example,omitempty"`
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pocket_openapi_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_openapi_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Response) GetCode() ResponseCode {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ResponseCode_RESPONSE_CODE_OK
+}
+
+func (x *Response) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Response) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
 }
 
-func (x *OpenapiServer) GetUrl() string {
-	if x != nil && x.Url != nil {
-		return *x.Url
+func (x *Response) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
 	}
 	return ""
 }
 
-func (x *OpenapiServer) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *Response) GetNoBody() bool {
+	if x != nil && x.NoBody != nil {
+		return *x.NoBody
+	}
+	return false
+}
+
+func (x *Response) GetRef() string {
+	if x != nil && x.Ref != nil {
+		return *x.Ref
 	}
 	return ""
 }
 
-type OpenapiMethod struct {
+func (x *Response) GetHeader() []*ResponseHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Response) GetExample() []*ResponseExample {
+	if x != nil {
+		return x.Example
+	}
+	return nil
+}
+
+// ResponseHeader declares a header set by a response.
+type ResponseHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Summary     *string     `protobuf:"bytes,1,req,name=summary" json:"summary,omitempty"`
-	Description *string     `protobuf:"bytes,2,req,name=description" json:"description,omitempty"`
-	Tags        []string    `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty"`
-	Response    []*Response `protobuf:"bytes,4,rep,name=response" json:"response,omitempty"`
+	// name sets the header name, like "Location". It is required unless the
+	// header is a ref, which is named after the shared header by default.
+	Name        *string         `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Description *string         `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Type        *HeaderType     `protobuf:"varint,3,opt,name=type,enum=pocket.openapi.HeaderType" json:"type,omitempty"`
+	Format      *PropertyFormat `protobuf:"varint,4,opt,name=format,enum=pocket.openapi.PropertyFormat" json:"format,omitempty"`
+	Required    *bool           `protobuf:"varint,5,opt,name=required" json:"required,omitempty"`
+	Example     *string         `protobuf:"bytes,6,opt,name=example" json:"example,omitempty"`
+	// ref uses a shared header, by its name, instead of declaring one.
+	Ref *string `protobuf:"bytes,7,opt,name=ref" json:"ref,omitempty"`
 }
 
-func (x *OpenapiMethod) Reset() {
-	*x = OpenapiMethod{}
+func (x *ResponseHeader) Reset() {
+	*x = ResponseHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_openapi_proto_msgTypes[1]
+		mi := &file_pocket_openapi_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenapiMethod) String() string {
+func (x *ResponseHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenapiMethod) ProtoMessage() {}
+func (*ResponseHeader) ProtoMessage() {}
 
-func (x *OpenapiMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_pocket_openapi_proto_msgTypes[1]
+func (x *ResponseHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_openapi_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,65 +928,89 @@ func (x *OpenapiMethod) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenapiMethod.ProtoReflect.Descriptor instead.
-func (*OpenapiMethod) Descriptor() ([]byte, []int) {
-	return file_pocket_openapi_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ResponseHeader.ProtoReflect.Descriptor instead.
+func (*ResponseHeader) Descriptor() ([]byte, []int) {
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *OpenapiMethod) GetSummary() string {
-	if x != nil && x.Summary != nil {
-		return *x.Summary
+func (x *ResponseHeader) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *OpenapiMethod) GetDescription() string {
+func (x *ResponseHeader) GetDescription() string {
 	if x != nil && x.Description != nil {
 		return *x.Description
 	}
 	return ""
 }
 
-func (x *OpenapiMethod) GetTags() []string {
-	if x != nil {
-		return x.Tags
+func (x *ResponseHeader) GetType() HeaderType {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
-	return nil
+	return HeaderType_HEADER_TYPE_STRING
 }
 
-func (x *OpenapiMethod) GetResponse() []*Response {
-	if x != nil {
-		return x.Response
+func (x *ResponseHeader) GetFormat() PropertyFormat {
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
-	return nil
+	return PropertyFormat_PROPERTY_FORMAT_UNSPECIFIED
 }
 
-type Response struct {
+func (x *ResponseHeader) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *ResponseHeader) GetExample() string {
+	if x != nil && x.Example != nil {
+		return *x.Example
+	}
+	return ""
+}
+
+func (x *ResponseHeader) GetRef() string {
+	if x != nil && x.Ref != nil {
+		return *x.Ref
+	}
+	return ""
+}
+
+// ResponseExample is a named example of a response body.
+type ResponseExample struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code        *ResponseCode `protobuf:"varint,1,req,name=code,enum=pocket.openapi.ResponseCode" json:"code,omitempty"`
-	Description *string       `protobuf:"bytes,2,req,name=description" json:"description,omitempty"`
+	Name    *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Summary *string `protobuf:"bytes,2,opt,name=summary" json:"summary,omitempty"`
+	// value sets the example body, in JSON.
+	Value *string `protobuf:"bytes,3,req,name=value" json:"value,omitempty"`
 }
 
-func (x *Response) Reset() {
-	*x = Response{}
+func (x *ResponseExample) Reset() {
+	*x = ResponseExample{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_openapi_proto_msgTypes[2]
+		mi := &file_pocket_openapi_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Response) String() string {
+func (x *ResponseExample) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Response) ProtoMessage() {}
+func (*ResponseExample) ProtoMessage() {}
 
-func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_pocket_openapi_proto_msgTypes[2]
+func (x *ResponseExample) ProtoReflect() protoreflect.Message {
+	mi := &file_pocket_openapi_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,21 +1021,28 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Response.ProtoReflect.Descriptor instead.
-func (*Response) Descriptor() ([]byte, []int) {
-	return file_pocket_openapi_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ResponseExample.ProtoReflect.Descriptor instead.
+func (*ResponseExample) Descriptor() ([]byte, []int) {
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Response) GetCode() ResponseCode {
-	if x != nil && x.Code != nil {
-		return *x.Code
+func (x *ResponseExample) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return ResponseCode_RESPONSE_CODE_OK
+	return ""
 }
 
-func (x *Response) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *ResponseExample) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+func (x *ResponseExample) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
 	}
 	return ""
 }
@@ -374,13 +1052,16 @@ type OpenapiMessage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Operation *Operation `protobuf:"bytes,1,req,name=operation" json:"operation,omitempty"`
+	Operation *Operation `protobuf:"bytes,1,opt,name=operation" json:"operation,omitempty"`
+	// sunset sets the date, like "2025-12-31", after which a deprecated message
+	// may be removed. It requires the message to set the deprecated option.
+	Sunset *string `protobuf:"bytes,2,opt,name=sunset" json:"sunset,omitempty"`
 }
 
 func (x *OpenapiMessage) Reset() {
 	*x = OpenapiMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_openapi_proto_msgTypes[3]
+		mi := &file_pocket_openapi_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -393,7 +1074,7 @@ func (x *OpenapiMessage) String() string {
 func (*OpenapiMessage) ProtoMessage() {}
 
 func (x *OpenapiMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_pocket_openapi_proto_msgTypes[3]
+	mi := &file_pocket_openapi_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,7 +1087,7 @@ func (x *OpenapiMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenapiMessage.ProtoReflect.Descriptor instead.
 func (*OpenapiMessage) Descriptor() ([]byte, []int) {
-	return file_pocket_openapi_proto_rawDescGZIP(), []int{3}
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *OpenapiMessage) GetOperation() *Operation {
@@ -416,6 +1097,13 @@ func (x *OpenapiMessage) GetOperation() *Operation {
 	return nil
 }
 
+func (x *OpenapiMessage) GetSunset() string {
+	if x != nil && x.Sunset != nil {
+		return *x.Sunset
+	}
+	return ""
+}
+
 type Operation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -427,7 +1115,7 @@ type Operation struct {
 func (x *Operation) Reset() {
 	*x = Operation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_openapi_proto_msgTypes[4]
+		mi := &file_pocket_openapi_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -440,7 +1128,7 @@ func (x *Operation) String() string {
 func (*Operation) ProtoMessage() {}
 
 func (x *Operation) ProtoReflect() protoreflect.Message {
-	mi := &file_pocket_openapi_proto_msgTypes[4]
+	mi := &file_pocket_openapi_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +1141,7 @@ func (x *Operation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Operation.ProtoReflect.Descriptor instead.
 func (*Operation) Descriptor() ([]byte, []int) {
-	return file_pocket_openapi_proto_rawDescGZIP(), []int{4}
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Operation) GetRequestBody() *RequestBody {
@@ -474,7 +1162,7 @@ type RequestBody struct {
 func (x *RequestBody) Reset() {
 	*x = RequestBody{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_openapi_proto_msgTypes[5]
+		mi := &file_pocket_openapi_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -487,7 +1175,7 @@ func (x *RequestBody) String() string {
 func (*RequestBody) ProtoMessage() {}
 
 func (x *RequestBody) ProtoReflect() protoreflect.Message {
-	mi := &file_pocket_openapi_proto_msgTypes[5]
+	mi := &file_pocket_openapi_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +1188,7 @@ func (x *RequestBody) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestBody.ProtoReflect.Descriptor instead.
 func (*RequestBody) Descriptor() ([]byte, []int) {
-	return file_pocket_openapi_proto_rawDescGZIP(), []int{5}
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RequestBody) GetDescription() string {
@@ -515,17 +1203,21 @@ type Property struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Description    *string         `protobuf:"bytes,1,req,name=description" json:"description,omitempty"`
+	// description defaults to the field comments when it is not set.
+	Description    *string         `protobuf:"bytes,1,opt,name=description" json:"description,omitempty"`
 	Example        *string         `protobuf:"bytes,2,opt,name=example" json:"example,omitempty"`
 	Format         *PropertyFormat `protobuf:"varint,3,opt,name=format,enum=pocket.openapi.PropertyFormat" json:"format,omitempty"`
 	Required       *bool           `protobuf:"varint,4,opt,name=required" json:"required,omitempty"`
 	HideFromSchema *bool           `protobuf:"varint,5,opt,name=hide_from_schema,json=hideFromSchema" json:"hide_from_schema,omitempty"`
+	// sunset sets the date, like "2025-12-31", after which a deprecated field
+	// may be removed. It requires the field to set the deprecated option.
+	Sunset *string `protobuf:"bytes,6,opt,name=sunset" json:"sunset,omitempty"`
 }
 
 func (x *Property) Reset() {
 	*x = Property{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pocket_openapi_proto_msgTypes[6]
+		mi := &file_pocket_openapi_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -538,7 +1230,7 @@ func (x *Property) String() string {
 func (*Property) ProtoMessage() {}
 
 func (x *Property) ProtoReflect() protoreflect.Message {
-	mi := &file_pocket_openapi_proto_msgTypes[6]
+	mi := &file_pocket_openapi_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,7 +1243,7 @@ func (x *Property) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Property.ProtoReflect.Descriptor instead.
 func (*Property) Descriptor() ([]byte, []int) {
-	return file_pocket_openapi_proto_rawDescGZIP(), []int{6}
+	return file_pocket_openapi_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Property) GetDescription() string {
@@ -589,6 +1281,13 @@ func (x *Property) GetHideFromSchema() bool {
 	return false
 }
 
+func (x *Property) GetSunset() string {
+	if x != nil && x.Sunset != nil {
+		return *x.Sunset
+	}
+	return ""
+}
+
 var file_pocket_openapi_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.FileOptions)(nil),
@@ -614,6 +1313,78 @@ var file_pocket_openapi_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,66043,rep,name=server",
 		Filename:      "pocket_openapi.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: ([]*SharedResponse)(nil),
+		Field:         66044,
+		Name:          "pocket.openapi.response",
+		Tag:           "bytes,66044,rep,name=response",
+		Filename:      "pocket_openapi.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: ([]*ResponseHeader)(nil),
+		Field:         66045,
+		Name:          "pocket.openapi.header",
+		Tag:           "bytes,66045,rep,name=header",
+		Filename:      "pocket_openapi.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*OpenapiLicense)(nil),
+		Field:         66046,
+		Name:          "pocket.openapi.license",
+		Tag:           "bytes,66046,opt,name=license",
+		Filename:      "pocket_openapi.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         66047,
+		Name:          "pocket.openapi.description",
+		Tag:           "bytes,66047,opt,name=description",
+		Filename:      "pocket_openapi.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         66048,
+		Name:          "pocket.openapi.terms_of_service",
+		Tag:           "bytes,66048,opt,name=terms_of_service",
+		Filename:      "pocket_openapi.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*OpenapiContact)(nil),
+		Field:         66049,
+		Name:          "pocket.openapi.contact",
+		Tag:           "bytes,66049,opt,name=contact",
+		Filename:      "pocket_openapi.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*OpenapiExternalDocs)(nil),
+		Field:         66050,
+		Name:          "pocket.openapi.external_docs",
+		Tag:           "bytes,66050,opt,name=external_docs",
+		Filename:      "pocket_openapi.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: ([]*OpenapiTag)(nil),
+		Field:         66051,
+		Name:          "pocket.openapi.tag",
+		Tag:           "bytes,66051,rep,name=tag",
+		Filename:      "pocket_openapi.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
+		ExtensionType: (*OpenapiService)(nil),
+		Field:         66041,
+		Name:          "pocket.openapi.service",
+		Tag:           "bytes,66041,opt,name=service",
+		Filename:      "pocket_openapi.proto",
+	},
 	{
 		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
 		ExtensionType: (*OpenapiMethod)(nil),
@@ -656,24 +1427,65 @@ var (
 	//
 	// repeated pocket.openapi.OpenapiServer server = 66043;
 	E_Server = &file_pocket_openapi_proto_extTypes[2]
+	// Defines responses shared by all operations of the file, which reference
+	// them by their names.
+	//
+	// repeated pocket.openapi.SharedResponse response = 66044;
+	E_Response = &file_pocket_openapi_proto_extTypes[3]
+	// Defines headers shared by all responses of the file, which reference
+	// them by their names.
+	//
+	// repeated pocket.openapi.ResponseHeader header = 66045;
+	E_Header = &file_pocket_openapi_proto_extTypes[4]
+	// Sets the license of the API.
+	//
+	// optional pocket.openapi.OpenapiLicense license = 66046;
+	E_License = &file_pocket_openapi_proto_extTypes[5]
+	// Sets the description of the API, which may use markdown.
+	//
+	// optional string description = 66047;
+	E_Description = &file_pocket_openapi_proto_extTypes[6]
+	// Sets the URL of the terms of service of the API.
+	//
+	// optional string terms_of_service = 66048;
+	E_TermsOfService = &file_pocket_openapi_proto_extTypes[7]
+	// Sets the contact information of the API.
+	//
+	// optional pocket.openapi.OpenapiContact contact = 66049;
+	E_Contact = &file_pocket_openapi_proto_extTypes[8]
+	// Sets the external documentation of the API.
+	//
+	// optional pocket.openapi.OpenapiExternalDocs external_docs = 66050;
+	E_ExternalDocs = &file_pocket_openapi_proto_extTypes[9]
+	// Declares the tags used by the operations, in the order that they must be
+	// presented.
+	//
+	// repeated pocket.openapi.OpenapiTag tag = 66051;
+	E_Tag = &file_pocket_openapi_proto_extTypes[10]
+)
+
+// Extension fields to descriptorpb.ServiceOptions.
+var (
+	// optional pocket.openapi.OpenapiService service = 66041;
+	E_Service = &file_pocket_openapi_proto_extTypes[11]
 )
 
 // Extension fields to descriptorpb.MethodOptions.
 var (
 	// optional pocket.openapi.OpenapiMethod operation = 66041;
-	E_Operation = &file_pocket_openapi_proto_extTypes[3]
+	E_Operation = &file_pocket_openapi_proto_extTypes[12]
 )
 
 // Extension fields to descriptorpb.MessageOptions.
 var (
 	// optional pocket.openapi.OpenapiMessage message = 66041;
-	E_Message = &file_pocket_openapi_proto_extTypes[4]
+	E_Message = &file_pocket_openapi_proto_extTypes[13]
 )
 
 // Extension fields to descriptorpb.FieldOptions.
 var (
 	// optional pocket.openapi.Property property = 66041;
-	E_Property = &file_pocket_openapi_proto_extTypes[5]
+	E_Property = &file_pocket_openapi_proto_extTypes[14]
 )
 
 var File_pocket_openapi_proto protoreflect.FileDescriptor
@@ -683,52 +1495,137 @@ var file_pocket_openapi_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f,
 	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x43, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x6e,
+	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4c, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x49, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x54, 0x61, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f,
+	0x63, 0x73, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73,
+	0x22, 0x56, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x4c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x6e,
 	0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
 	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64,
 	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x95, 0x01,
-	0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09,
-	0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12,
-	0x34, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61,
-	0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x30, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0e, 0x32,
-	0x1c, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01,
+	0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x3a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x22, 0x5a, 0x0a, 0x0e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xc7, 0x01, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x22, 0xa8, 0x02, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x5f, 0x62, 0x6f,
+	0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x42, 0x6f, 0x64, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72,
+	0x65, 0x66, 0x12, 0x36, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x07, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x36,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x65, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x55,
+	0x0a, 0x0f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x61, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
 	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6f, 0x63,
 	0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x72,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x4b, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a,
-	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20,
-	0x02, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79,
-	0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x2f, 0x0a,
-	0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc4,
-	0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x68,
-	0x69, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68, 0x69, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2a, 0xe4, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x22, 0x4b, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x2f, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x6f, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xdc, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12,
+	0x36, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x68,
+	0x69, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x75, 0x6e, 0x73, 0x65, 0x74, 0x2a, 0x6e, 0x0a, 0x0a, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x48,
+	0x45, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47,
+	0x45, 0x52, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13,
+	0x48, 0x45, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x4c,
+	0x45, 0x41, 0x4e, 0x10, 0x03, 0x2a, 0xe4, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
 	0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17,
 	0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f,
@@ -775,29 +1672,78 @@ var file_pocket_openapi_proto_rawDesc = []byte{
 	0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xfb,
 	0x83, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e,
 	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x3a, 0x5d, 0x0a,
-	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf9, 0x83, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x5b, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf9, 0x83, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
-	0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x55, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf9, 0x83, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
-	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
-	0x42, 0x49, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72,
-	0x73, 0x66, 0x72, 0x65, 0x69, 0x74, 0x61, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
-	0x67, 0x65, 0x6e, 0x2d, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2d, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x3b, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x3a, 0x5a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xfc, 0x83, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x3a, 0x56, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xfd, 0x83, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x3a, 0x58, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xfe, 0x83, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x4c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x3a, 0x40, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xff, 0x83, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x48, 0x0a,
+	0x10, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x80, 0x84, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x4f, 0x66,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x58, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x81, 0x84, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x3a, 0x68, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x6f,
+	0x63, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x82, 0x84, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x52, 0x0c, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x44, 0x6f, 0x63, 0x73, 0x3a, 0x4c, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x83, 0x84, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x3a, 0x5b, 0x0a, 0x07, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf9, 0x83, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x5d, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0xf9, 0x83, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x5b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xf9, 0x83, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x3a, 0x55, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x1d,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf9, 0x83,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x42, 0x49, 0x5a, 0x47, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x73, 0x66, 0x72, 0x65, 0x69, 0x74, 0x61,
+	0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x70, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x2d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x3b, 0x70, 0x6f,
+	0x63, 0x6b, 0x65, 0x74,
 }
 
 var (
@@ -812,44 +1758,78 @@ func file_pocket_openapi_proto_rawDescGZIP() []byte {
 	return file_pocket_openapi_proto_rawDescData
 }
 
-var file_pocket_openapi_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pocket_openapi_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_pocket_openapi_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_pocket_openapi_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_pocket_openapi_proto_goTypes = []interface{}{
-	(ResponseCode)(0),                   // 0: pocket.openapi.ResponseCode
-	(PropertyFormat)(0),                 // 1: pocket.openapi.PropertyFormat
-	(*OpenapiServer)(nil),               // 2: pocket.openapi.OpenapiServer
-	(*OpenapiMethod)(nil),               // 3: pocket.openapi.OpenapiMethod
-	(*Response)(nil),                    // 4: pocket.openapi.Response
-	(*OpenapiMessage)(nil),              // 5: pocket.openapi.OpenapiMessage
-	(*Operation)(nil),                   // 6: pocket.openapi.Operation
-	(*RequestBody)(nil),                 // 7: pocket.openapi.RequestBody
-	(*Property)(nil),                    // 8: pocket.openapi.Property
-	(*descriptorpb.FileOptions)(nil),    // 9: google.protobuf.FileOptions
-	(*descriptorpb.MethodOptions)(nil),  // 10: google.protobuf.MethodOptions
-	(*descriptorpb.MessageOptions)(nil), // 11: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),   // 12: google.protobuf.FieldOptions
+	(HeaderType)(0),                     // 0: pocket.openapi.HeaderType
+	(ResponseCode)(0),                   // 1: pocket.openapi.ResponseCode
+	(PropertyFormat)(0),                 // 2: pocket.openapi.PropertyFormat
+	(*OpenapiContact)(nil),              // 3: pocket.openapi.OpenapiContact
+	(*OpenapiExternalDocs)(nil),         // 4: pocket.openapi.OpenapiExternalDocs
+	(*OpenapiTag)(nil),                  // 5: pocket.openapi.OpenapiTag
+	(*OpenapiLicense)(nil),              // 6: pocket.openapi.OpenapiLicense
+	(*OpenapiServer)(nil),               // 7: pocket.openapi.OpenapiServer
+	(*OpenapiService)(nil),              // 8: pocket.openapi.OpenapiService
+	(*SharedResponse)(nil),              // 9: pocket.openapi.SharedResponse
+	(*OpenapiMethod)(nil),               // 10: pocket.openapi.OpenapiMethod
+	(*Response)(nil),                    // 11: pocket.openapi.Response
+	(*ResponseHeader)(nil),              // 12: pocket.openapi.ResponseHeader
+	(*ResponseExample)(nil),             // 13: pocket.openapi.ResponseExample
+	(*OpenapiMessage)(nil),              // 14: pocket.openapi.OpenapiMessage
+	(*Operation)(nil),                   // 15: pocket.openapi.Operation
+	(*RequestBody)(nil),                 // 16: pocket.openapi.RequestBody
+	(*Property)(nil),                    // 17: pocket.openapi.Property
+	(*descriptorpb.FileOptions)(nil),    // 18: google.protobuf.FileOptions
+	(*descriptorpb.ServiceOptions)(nil), // 19: google.protobuf.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),  // 20: google.protobuf.MethodOptions
+	(*descriptorpb.MessageOptions)(nil), // 21: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),   // 22: google.protobuf.FieldOptions
 }
 var file_pocket_openapi_proto_depIdxs = []int32{
-	4,  // 0: pocket.openapi.OpenapiMethod.response:type_name -> pocket.openapi.Response
-	0,  // 1: pocket.openapi.Response.code:type_name -> pocket.openapi.ResponseCode
-	6,  // 2: pocket.openapi.OpenapiMessage.operation:type_name -> pocket.openapi.Operation
-	7,  // 3: pocket.openapi.Operation.request_body:type_name -> pocket.openapi.RequestBody
-	1,  // 4: pocket.openapi.Property.format:type_name -> pocket.openapi.PropertyFormat
-	9,  // 5: pocket.openapi.title:extendee -> google.protobuf.FileOptions
-	9,  // 6: pocket.openapi.version:extendee -> google.protobuf.FileOptions
-	9,  // 7: pocket.openapi.server:extendee -> google.protobuf.FileOptions
-	10, // 8: pocket.openapi.operation:extendee -> google.protobuf.MethodOptions
-	11, // 9: pocket.openapi.message:extendee -> google.protobuf.MessageOptions
-	12, // 10: pocket.openapi.property:extendee -> google.protobuf.FieldOptions
-	2,  // 11: pocket.openapi.server:type_name -> pocket.openapi.OpenapiServer
-	3,  // 12: pocket.openapi.operation:type_name -> pocket.openapi.OpenapiMethod
-	5,  // 13: pocket.openapi.message:type_name -> pocket.openapi.OpenapiMessage
-	8,  // 14: pocket.openapi.property:type_name -> pocket.openapi.Property
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	11, // [11:15] is the sub-list for extension type_name
-	5,  // [5:11] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	4,  // 0: pocket.openapi.OpenapiTag.external_docs:type_name -> pocket.openapi.OpenapiExternalDocs
+	9,  // 1: pocket.openapi.OpenapiService.response:type_name -> pocket.openapi.SharedResponse
+	12, // 2: pocket.openapi.OpenapiService.header:type_name -> pocket.openapi.ResponseHeader
+	11, // 3: pocket.openapi.SharedResponse.response:type_name -> pocket.openapi.Response
+	11, // 4: pocket.openapi.OpenapiMethod.response:type_name -> pocket.openapi.Response
+	1,  // 5: pocket.openapi.Response.code:type_name -> pocket.openapi.ResponseCode
+	12, // 6: pocket.openapi.Response.header:type_name -> pocket.openapi.ResponseHeader
+	13, // 7: pocket.openapi.Response.example:type_name -> pocket.openapi.ResponseExample
+	0,  // 8: pocket.openapi.ResponseHeader.type:type_name -> pocket.openapi.HeaderType
+	2,  // 9: pocket.openapi.ResponseHeader.format:type_name -> pocket.openapi.PropertyFormat
+	15, // 10: pocket.openapi.OpenapiMessage.operation:type_name -> pocket.openapi.Operation
+	16, // 11: pocket.openapi.Operation.request_body:type_name -> pocket.openapi.RequestBody
+	2,  // 12: pocket.openapi.Property.format:type_name -> pocket.openapi.PropertyFormat
+	18, // 13: pocket.openapi.title:extendee -> google.protobuf.FileOptions
+	18, // 14: pocket.openapi.version:extendee -> google.protobuf.FileOptions
+	18, // 15: pocket.openapi.server:extendee -> google.protobuf.FileOptions
+	18, // 16: pocket.openapi.response:extendee -> google.protobuf.FileOptions
+	18, // 17: pocket.openapi.header:extendee -> google.protobuf.FileOptions
+	18, // 18: pocket.openapi.license:extendee -> google.protobuf.FileOptions
+	18, // 19: pocket.openapi.description:extendee -> google.protobuf.FileOptions
+	18, // 20: pocket.openapi.terms_of_service:extendee -> google.protobuf.FileOptions
+	18, // 21: pocket.openapi.contact:extendee -> google.protobuf.FileOptions
+	18, // 22: pocket.openapi.external_docs:extendee -> google.protobuf.FileOptions
+	18, // 23: pocket.openapi.tag:extendee -> google.protobuf.FileOptions
+	19, // 24: pocket.openapi.service:extendee -> google.protobuf.ServiceOptions
+	20, // 25: pocket.openapi.operation:extendee -> google.protobuf.MethodOptions
+	21, // 26: pocket.openapi.message:extendee -> google.protobuf.MessageOptions
+	22, // 27: pocket.openapi.property:extendee -> google.protobuf.FieldOptions
+	7,  // 28: pocket.openapi.server:type_name -> pocket.openapi.OpenapiServer
+	9,  // 29: pocket.openapi.response:type_name -> pocket.openapi.SharedResponse
+	12, // 30: pocket.openapi.header:type_name -> pocket.openapi.ResponseHeader
+	6,  // 31: pocket.openapi.license:type_name -> pocket.openapi.OpenapiLicense
+	3,  // 32: pocket.openapi.contact:type_name -> pocket.openapi.OpenapiContact
+	4,  // 33: pocket.openapi.external_docs:type_name -> pocket.openapi.OpenapiExternalDocs
+	5,  // 34: pocket.openapi.tag:type_name -> pocket.openapi.OpenapiTag
+	8,  // 35: pocket.openapi.service:type_name -> pocket.openapi.OpenapiService
+	10, // 36: pocket.openapi.operation:type_name -> pocket.openapi.OpenapiMethod
+	14, // 37: pocket.openapi.message:type_name -> pocket.openapi.OpenapiMessage
+	17, // 38: pocket.openapi.property:type_name -> pocket.openapi.Property
+	39, // [39:39] is the sub-list for method output_type
+	39, // [39:39] is the sub-list for method input_type
+	28, // [28:39] is the sub-list for extension type_name
+	13, // [13:28] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_pocket_openapi_proto_init() }
@@ -859,7 +1839,7 @@ func file_pocket_openapi_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_pocket_openapi_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenapiServer); i {
+			switch v := v.(*OpenapiContact); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -871,7 +1851,7 @@ func file_pocket_openapi_proto_init() {
 			}
 		}
 		file_pocket_openapi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenapiMethod); i {
+			switch v := v.(*OpenapiExternalDocs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -883,7 +1863,7 @@ func file_pocket_openapi_proto_init() {
 			}
 		}
 		file_pocket_openapi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+			switch v := v.(*OpenapiTag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -895,7 +1875,7 @@ func file_pocket_openapi_proto_init() {
 			}
 		}
 		file_pocket_openapi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenapiMessage); i {
+			switch v := v.(*OpenapiLicense); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -907,7 +1887,7 @@ func file_pocket_openapi_proto_init() {
 			}
 		}
 		file_pocket_openapi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Operation); i {
+			switch v := v.(*OpenapiServer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -919,7 +1899,7 @@ func file_pocket_openapi_proto_init() {
 			}
 		}
 		file_pocket_openapi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestBody); i {
+			switch v := v.(*OpenapiService); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -931,6 +1911,102 @@ func file_pocket_openapi_proto_init() {
 			}
 		}
 		file_pocket_openapi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_openapi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenapiMethod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_openapi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_openapi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_openapi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseExample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_openapi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenapiMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_openapi_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Operation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_openapi_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pocket_openapi_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Property); i {
 			case 0:
 				return &v.state
@@ -948,9 +2024,9 @@ func file_pocket_openapi_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pocket_openapi_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   7,
-			NumExtensions: 6,
+			NumEnums:      3,
+			NumMessages:   15,
+			NumExtensions: 15,
 			NumServices:   0,
 		},
 		GoTypes:           file_pocket_openapi_proto_goTypes,